@@ -0,0 +1,23 @@
+resource "discue_domain" "example" {
+  alias    = "my-domain"
+  hostname = "www.example.com"
+  port     = 443
+}
+
+# verify the domain once the challenge file was uploaded
+action "discue_verify_domain" "example" {
+  config {
+    domain_id = discue_domain.example.id
+  }
+}
+
+resource "terraform_data" "challenge_uploaded" {
+  input = discue_domain.example.challenge.https.file_content
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.discue_verify_domain.example]
+    }
+  }
+}
@@ -0,0 +1,3 @@
+data "discue_api_key" "ci" {
+  alias = "ci-api-key"
+}
@@ -0,0 +1,3 @@
+data "discue_api_keys" "disabled" {
+  status = "disabled"
+}
@@ -0,0 +1,3 @@
+data "discue_domain" "shared" {
+  alias = "shared-domain"
+}
@@ -0,0 +1,3 @@
+data "discue_domains" "example" {
+  alias_regex = "^(prod|staging)-domain$"
+}
@@ -0,0 +1,8 @@
+data "discue_queue" "shared" {
+  alias = "shared-queue"
+}
+
+data "discue_listener" "shared" {
+  queue_id = data.discue_queue.shared.id
+  alias    = "shared-listener"
+}
@@ -0,0 +1,7 @@
+data "discue_queues" "shared" {
+  alias = "shared-queue"
+}
+
+data "discue_listeners" "shared" {
+  queue_id = data.discue_queues.shared.ids[0]
+}
@@ -0,0 +1,3 @@
+data "discue_queue" "shared" {
+  alias = "shared-queue"
+}
@@ -0,0 +1,3 @@
+data "discue_queues" "shared" {
+  alias_prefix = "shared-"
+}
@@ -0,0 +1,6 @@
+import {
+  to = discue_api_client.example
+  identity = {
+    id = "QYnSqtbqHzQhCk7wyz0NS"
+  }
+}
@@ -0,0 +1,8 @@
+resource "discue_api_client" "example" {
+  alias = "my-api-client"
+  scopes = [{
+    resource = "subscriptions"
+    access   = "read"
+    targets  = ["*"]
+  }]
+}
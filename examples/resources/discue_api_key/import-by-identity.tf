@@ -0,0 +1,6 @@
+import {
+  to = discue_api_key.example
+  identity = {
+    id = "QYnSqtbqHzQhCk7wyz0NS"
+  }
+}
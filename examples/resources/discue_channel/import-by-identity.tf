@@ -0,0 +1,6 @@
+import {
+  to = discue_channel.example
+  identity = {
+    id = "QYnSqtbqHzQhCk7wyz0NS"
+  }
+}
@@ -0,0 +1,12 @@
+resource "discue_domain" "example" {
+  alias    = "my-domain"
+  hostname = "discue.io"
+  port     = 443
+}
+
+# pushes messages to https://discue.io:443/messages once the domain was verified
+resource "discue_channel" "example" {
+  alias     = "my-channel"
+  domain_id = discue_domain.example.id
+  path      = "/messages"
+}
@@ -0,0 +1,6 @@
+import {
+  to = discue_domain.example
+  identity = {
+    id = "QYnSqtbqHzQhCk7wyz0NS"
+  }
+}
@@ -2,4 +2,29 @@ resource "discue_domain" "test_domain" {
   alias    = "my-first-domain"
   hostname = "discue.io"
   port     = 443
+
+  # only finish the apply once the domain challenge was passed
+  wait_for_verification = true
+
+  timeouts {
+    create = "30m"
+  }
+
+  # changing hostname or port replaces the domain, create the
+  # new domain first so that dependent listeners keep working
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+# domains behind a private ingress can be verified with a TXT record instead
+resource "discue_domain" "private_domain" {
+  alias          = "my-private-domain"
+  hostname       = "internal.discue.io"
+  port           = 443
+  challenge_type = "dns"
+}
+
+output "dns_challenge_record" {
+  value = discue_domain.private_domain.challenge.dns
 }
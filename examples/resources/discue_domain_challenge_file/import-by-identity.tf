@@ -0,0 +1,7 @@
+import {
+  to = discue_domain_challenge_file.example
+  identity = {
+    domain_id = "QYnSqtbqHzQhCk7wyz0NS"
+    web_root  = "/var/www/html"
+  }
+}
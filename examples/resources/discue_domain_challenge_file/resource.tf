@@ -0,0 +1,11 @@
+resource "discue_domain" "example" {
+  alias    = "my-domain"
+  hostname = "www.example.com"
+  port     = 443
+}
+
+# serve the challenge from the web root of the local web server
+resource "discue_domain_challenge_file" "example" {
+  domain_id = discue_domain.example.id
+  web_root  = "/var/www/html"
+}
@@ -0,0 +1,7 @@
+import {
+  to = discue_listener.example
+  identity = {
+    queue_id = "QYnSqtbqHzQhCk7wyz0NS"
+    id       = "Wm4EwRQdnH2N5yWGiJ4oT"
+  }
+}
@@ -14,4 +14,38 @@ resource "discue_listener" "test_listener" {
   alias        = "my-first-listener"
   liveness_url = "https://${discue_domain.test_domain.hostname}:${discue_domain.test_domain.port}/live"
   notify_url   = "https://${discue_domain.test_domain.hostname}:${discue_domain.test_domain.port}/notify"
+
+  delivery {
+    headers = {
+      Authorization = "Bearer ${var.listener_token}"
+    }
+    timeout_ms   = 5000
+    max_attempts = 3
+    backoff      = "exponential"
+  }
+
+  # the listener is no longer live once three probes in a row
+  # did not respond with status 204 within two seconds
+  liveness {
+    interval_seconds  = 30
+    timeout_ms        = 2000
+    failure_threshold = 3
+    expected_status   = 204
+  }
+
+  # the secret is never stored in state, increase the
+  # version to send a rotated secret to the API
+  signing_secret_wo      = var.listener_signing_secret
+  signing_secret_version = 1
+}
+
+variable "listener_token" {
+  type      = string
+  sensitive = true
+}
+
+variable "listener_signing_secret" {
+  type      = string
+  sensitive = true
+  ephemeral = true
 }
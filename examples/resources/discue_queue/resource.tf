@@ -1,3 +1,17 @@
 resource "discue_queue" "example" {
   alias = "my-alias"
 }
+
+resource "discue_queue" "dead_letters" {
+  alias = "my-dead-letters"
+}
+
+# keep messages for one day and move them to the dead letter
+# queue after five failed delivery attempts
+resource "discue_queue" "with_delivery_settings" {
+  alias                     = "my-queue-with-delivery-settings"
+  message_retention_seconds = 86400
+  max_delivery_attempts     = 5
+  retry_backoff_seconds     = 30
+  dead_letter_queue_id      = discue_queue.dead_letters.id
+}
@@ -0,0 +1,11 @@
+resource "discue_schema" "example" {
+  alias = "my-schema"
+  definition = jsonencode({
+    type = "object"
+    properties = {
+      id    = { type = "string" }
+      total = { type = "number", minimum = 0 }
+    }
+    required = ["id"]
+  })
+}
@@ -0,0 +1,15 @@
+resource "discue_topic" "example" {
+  alias = "my-topic"
+}
+
+resource "discue_queue" "example" {
+  alias = "my-queue"
+}
+
+resource "discue_subscription" "example" {
+  topic_id = discue_topic.example.id
+  queue_id = discue_queue.example.id
+
+  alias   = "my-subscription"
+  filters = ["type == 'order.created'"]
+}
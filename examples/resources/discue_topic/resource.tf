@@ -0,0 +1,3 @@
+resource "discue_topic" "example" {
+  alias = "my-alias"
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const apiClientsPathName string = "api_clients"
+const singleApiClientResponseName string = "api_client"
+
+func (c *Client) GetApiClient(ctx context.Context, apiClientId string) (*ApiClientResponse, error) {
+	requestOptions := RequestOptions{
+		Method:       http.MethodGet,
+		Path:         fmt.Sprintf("/%s/%s", apiClientsPathName, apiClientId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[ApiClientResponse](ctx, c, requestOptions, singleApiClientResponseName)
+}
+
+func (c *Client) CreateApiClient(ctx context.Context, newApiClient ApiClientRequest) (*ApiClientResponse, error) {
+	requestOptions := RequestOptions{
+		Body:         newApiClient,
+		Method:       http.MethodPost,
+		Path:         fmt.Sprintf("/%s", apiClientsPathName),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[ApiClientResponse](ctx, c, requestOptions, singleApiClientResponseName)
+}
+
+func (c *Client) UpdateApiClient(ctx context.Context, apiClientId string, updatedApiClient ApiClientRequest) (*ApiClientResponse, error) {
+	requestOptions := RequestOptions{
+		Body:         updatedApiClient,
+		Method:       http.MethodPut,
+		Path:         fmt.Sprintf("/%s/%s", apiClientsPathName, apiClientId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[ApiClientResponse](ctx, c, requestOptions, singleApiClientResponseName)
+}
+
+func (c *Client) DeleteApiClient(ctx context.Context, apiClientId string) (*ApiClientResponse, error) {
+	requestOptions := RequestOptions{
+		Method:       http.MethodDelete,
+		Path:         fmt.Sprintf("/%s/%s", apiClientsPathName, apiClientId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[ApiClientResponse](ctx, c, requestOptions, "_links") // because delete requests will not have an entity in the response
+}
@@ -0,0 +1,14 @@
+package client
+
+type ApiClientRequest struct {
+	Alias  string        `json:"alias"`
+	Scopes *ApiKeyScopes `json:"scopes,omitempty"`
+}
+
+type ApiClientResponse struct {
+	Id           string        `json:"id"`
+	Alias        string        `json:"alias"`
+	ClientSecret string        `json:"client_secret,omitempty"`
+	Scopes       *ApiKeyScopes `json:"scopes,omitempty"`
+	CreatedAt    int64         `json:"created_at,omitempty"`
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -8,17 +9,27 @@ import (
 const apiKeysPathName string = "api_keys"
 const singleKeyResponseName string = "api_key"
 
-func (c *Client) GetApiKey(apiKeyId string) (*ApiKeyResponse, error) {
+func (c *Client) GetApiKey(ctx context.Context, apiKeyId string) (*ApiKeyResponse, error) {
 	requestOptions := RequestOptions{
 		Method:       http.MethodGet,
 		Path:         fmt.Sprintf("/%s/%s", apiKeysPathName, apiKeyId),
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[ApiKeyResponse](c, requestOptions, singleKeyResponseName)
+	return sendAndReceive[ApiKeyResponse](ctx, c, requestOptions, singleKeyResponseName)
 }
 
-func (c *Client) CreateApiKey(newApiKey ApiKeyRequest) (*ApiKeyResponse, error) {
+func (c *Client) ListApiKeys(ctx context.Context) ([]ApiKeyResponse, error) {
+	requestOptions := RequestOptions{
+		Method:       http.MethodGet,
+		Path:         fmt.Sprintf("/%s", apiKeysPathName),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return collectPages(sendAndReceivePages[ApiKeyResponse](ctx, c, requestOptions, apiKeysPathName))
+}
+
+func (c *Client) CreateApiKey(ctx context.Context, newApiKey ApiKeyRequest) (*ApiKeyResponse, error) {
 	requestOptions := RequestOptions{
 		Body:         newApiKey,
 		Method:       http.MethodPost,
@@ -26,10 +37,10 @@ func (c *Client) CreateApiKey(newApiKey ApiKeyRequest) (*ApiKeyResponse, error)
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[ApiKeyResponse](c, requestOptions, singleKeyResponseName)
+	return sendAndReceive[ApiKeyResponse](ctx, c, requestOptions, singleKeyResponseName)
 }
 
-func (c *Client) UpdateApiKey(apiKeyId string, updatedApiKey ApiKeyRequest) (*ApiKeyResponse, error) {
+func (c *Client) UpdateApiKey(ctx context.Context, apiKeyId string, updatedApiKey ApiKeyRequest) (*ApiKeyResponse, error) {
 	requestOptions := RequestOptions{
 		Body:         updatedApiKey,
 		Method:       http.MethodPut,
@@ -37,15 +48,15 @@ func (c *Client) UpdateApiKey(apiKeyId string, updatedApiKey ApiKeyRequest) (*Ap
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[ApiKeyResponse](c, requestOptions, singleKeyResponseName)
+	return sendAndReceive[ApiKeyResponse](ctx, c, requestOptions, singleKeyResponseName)
 }
 
-func (c *Client) DeleteApiKey(apiKeyId string) (*ApiKeyResponse, error) {
+func (c *Client) DeleteApiKey(ctx context.Context, apiKeyId string) (*ApiKeyResponse, error) {
 	requestOptions := RequestOptions{
 		Method:       http.MethodDelete,
 		Path:         fmt.Sprintf("/%s/%s", apiKeysPathName, apiKeyId),
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[ApiKeyResponse](c, requestOptions, "_links") // because delete requests will not have an entity in the response
+	return sendAndReceive[ApiKeyResponse](ctx, c, requestOptions, "_links") // because delete requests will not have an entity in the response
 }
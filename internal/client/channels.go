@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const channelsResourceName string = "channels"
+const singleChannelResponseKey string = "channel"
+
+func (c *Client) GetChannel(ctx context.Context, channelId string) (*Channel, error) {
+	requestOptions := RequestOptions{
+		Method:       http.MethodGet,
+		Path:         fmt.Sprintf("/%s/%s", channelsResourceName, channelId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[Channel](ctx, c, requestOptions, singleChannelResponseKey)
+}
+
+func (c *Client) CreateChannel(ctx context.Context, newChannel Channel) (*Channel, error) {
+	requestOptions := RequestOptions{
+		Body:         newChannel,
+		Method:       http.MethodPost,
+		Path:         fmt.Sprintf("/%s", channelsResourceName),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[Channel](ctx, c, requestOptions, singleChannelResponseKey)
+}
+
+func (c *Client) UpdateChannel(ctx context.Context, channelId string, updatedChannel Channel) (*Channel, error) {
+	requestOptions := RequestOptions{
+		Body:         updatedChannel,
+		Method:       http.MethodPut,
+		Path:         fmt.Sprintf("/%s/%s", channelsResourceName, channelId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[Channel](ctx, c, requestOptions, singleChannelResponseKey)
+}
+
+func (c *Client) DeleteChannel(ctx context.Context, channelId string) (*Channel, error) {
+	requestOptions := RequestOptions{
+		Method:       http.MethodDelete,
+		Path:         fmt.Sprintf("/%s/%s", channelsResourceName, channelId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[Channel](ctx, c, requestOptions, "_links") // because delete requests will not have an entity in the response
+}
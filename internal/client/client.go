@@ -1,26 +1,35 @@
 package client
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
 	"time"
 )
 
-func NewClient(apiEndpoint string, apiKey *string) (*Client, error) {
+// ClientOption configures optional behavior of a Client.
+type ClientOption func(*Client)
+
+func NewClient(apiEndpoint string, apiKey *string, options ...ClientOption) (*Client, error) {
 	c := Client{
-		HttpClient:  &http.Client{Timeout: 10 * time.Second},
-		ApiEndpoint: apiEndpoint,
-		ApiKey:      *apiKey,
+		HttpClient:   &http.Client{Timeout: 10 * time.Second},
+		ApiEndpoint:  apiEndpoint,
+		ApiKey:       *apiKey,
+		RetryOptions: DefaultRetryOptions(),
+	}
+
+	for _, option := range options {
+		option(&c)
 	}
 
 	return &c, nil
 }
 
-func sendAndReceive[T any](c *Client, requestOptions RequestOptions, jsonKey string) (*T, error) {
-	body, err := c.executeRequest(requestOptions)
+func sendAndReceive[T any](ctx context.Context, c *Client, requestOptions RequestOptions, jsonKey string) (*T, error) {
+	body, err := c.executeRequest(ctx, requestOptions)
 	if err != nil {
 		return nil, fmt.Errorf("could not execute request: %w", err)
 	}
@@ -45,28 +54,65 @@ func sendAndReceive[T any](c *Client, requestOptions RequestOptions, jsonKey str
 	return nil, fmt.Errorf("could not unmarshal resource from response: %s", rawMap)
 }
 
-func (c *Client) executeRequest(requestOptions RequestOptions) ([]byte, error) {
-	var br io.Reader = nil
+func (c *Client) executeRequest(ctx context.Context, requestOptions RequestOptions) ([]byte, error) {
+	var payload []byte
 	if requestOptions.Body != nil {
-		bytes, err := json.Marshal(requestOptions.Body)
+		encoded, err := json.Marshal(requestOptions.Body)
 		if err != nil {
 			return nil, err
 		}
-		br = strings.NewReader(string(bytes))
+		payload = encoded
+	}
+
+	for attempt := 0; ; attempt++ {
+		res, body, err := c.doRequest(ctx, requestOptions, payload)
+
+		if err == nil && res.StatusCode == requestOptions.ExpectStatus {
+			return body, nil
+		}
+
+		if err == nil {
+			err = newAPIError(res, requestOptions, body)
+		}
+
+		if attempt >= c.RetryOptions.MaxRetries || !shouldRetry(requestOptions.Method, res, err) {
+			return nil, err
+		}
+
+		wait := c.RetryOptions.backoff(attempt, res)
+		logRetry(ctx, requestOptions, attempt+1, wait, err)
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
 	}
+}
 
-	req, err := http.NewRequest(requestOptions.Method, fmt.Sprintf("%s%s", c.ApiEndpoint, requestOptions.Path), br)
+// doRequest sends a single request and returns the response together with the already consumed body.
+func (c *Client) doRequest(ctx context.Context, requestOptions RequestOptions, payload []byte) (*http.Response, []byte, error) {
+	var br io.Reader = nil
+	if payload != nil {
+		br = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, requestOptions.Method, fmt.Sprintf("%s%s", c.ApiEndpoint, requestOptions.Path), br)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	req.Header.Set("x-api-key", c.ApiKey)
 	req.Header.Set("content-type", "application/json")
 	req.Header.Set("accept", "application/json")
 
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
 	res, err := c.HttpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer func() {
@@ -78,12 +124,8 @@ func (c *Client) executeRequest(requestOptions RequestOptions) ([]byte, error) {
 
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
-	}
-
-	if res.StatusCode != requestOptions.ExpectStatus {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return nil, nil, err
 	}
 
-	return body, err
+	return res, body, nil
 }
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -8,17 +9,27 @@ import (
 const domainsPathName string = "domains"
 const singleDomainResponseKey string = "domain"
 
-func (c *Client) GetDomain(domainId string) (*DomainResponse, error) {
+func (c *Client) GetDomain(ctx context.Context, domainId string) (*DomainResponse, error) {
 	requestOptions := RequestOptions{
 		Method:       http.MethodGet,
 		Path:         fmt.Sprintf("/%s/%s", domainsPathName, domainId),
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[DomainResponse](c, requestOptions, singleDomainResponseKey)
+	return sendAndReceive[DomainResponse](ctx, c, requestOptions, singleDomainResponseKey)
 }
 
-func (c *Client) CreateDomain(newDomain DomainRequest) (*DomainResponse, error) {
+func (c *Client) ListDomains(ctx context.Context) ([]DomainResponse, error) {
+	requestOptions := RequestOptions{
+		Method:       http.MethodGet,
+		Path:         fmt.Sprintf("/%s", domainsPathName),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return collectPages(sendAndReceivePages[DomainResponse](ctx, c, requestOptions, domainsPathName))
+}
+
+func (c *Client) CreateDomain(ctx context.Context, newDomain DomainRequest) (*DomainResponse, error) {
 	requestOptions := RequestOptions{
 		Body:         newDomain,
 		Method:       http.MethodPost,
@@ -26,10 +37,10 @@ func (c *Client) CreateDomain(newDomain DomainRequest) (*DomainResponse, error)
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[DomainResponse](c, requestOptions, singleDomainResponseKey)
+	return sendAndReceive[DomainResponse](ctx, c, requestOptions, singleDomainResponseKey)
 }
 
-func (c *Client) UpdateDomain(domainId string, updatedDomain DomainRequest) (*DomainResponse, error) {
+func (c *Client) UpdateDomain(ctx context.Context, domainId string, updatedDomain DomainRequest) (*DomainResponse, error) {
 	requestOptions := RequestOptions{
 		Body:         updatedDomain,
 		Method:       http.MethodPut,
@@ -37,15 +48,27 @@ func (c *Client) UpdateDomain(domainId string, updatedDomain DomainRequest) (*Do
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[DomainResponse](c, requestOptions, singleDomainResponseKey)
+	return sendAndReceive[DomainResponse](ctx, c, requestOptions, singleDomainResponseKey)
+}
+
+// VerifyDomain asks the API to check the challenge of the domain again. The check
+// runs asynchronously, the verification status is pending until it finished.
+func (c *Client) VerifyDomain(ctx context.Context, domainId string) (*DomainResponse, error) {
+	requestOptions := RequestOptions{
+		Method:       http.MethodPost,
+		Path:         fmt.Sprintf("/%s/%s/verify", domainsPathName, domainId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[DomainResponse](ctx, c, requestOptions, singleDomainResponseKey)
 }
 
-func (c *Client) DeleteDomain(domainId string) (*DomainResponse, error) {
+func (c *Client) DeleteDomain(ctx context.Context, domainId string) (*DomainResponse, error) {
 	requestOptions := RequestOptions{
 		Method:       http.MethodDelete,
 		Path:         fmt.Sprintf("/%s/%s", domainsPathName, domainId),
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[DomainResponse](c, requestOptions, "_links") // because delete requests will not have an entity in the response
+	return sendAndReceive[DomainResponse](ctx, c, requestOptions, "_links") // because delete requests will not have an entity in the response
 }
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var ErrDomainChallengeExpired = errors.New("the domain challenge expired before the domain was verified")
+
+// WaitOptions configure how long to wait between two requests while polling the API.
+type WaitOptions struct {
+	MinWait time.Duration
+	MaxWait time.Duration
+	// Progress, if set, is called with every domain received from the API while waiting.
+	Progress func(d *DomainResponse)
+}
+
+func DefaultWaitOptions() WaitOptions {
+	return WaitOptions{
+		MinWait: 2 * time.Second,
+		MaxWait: 30 * time.Second,
+	}
+}
+
+// WaitForDomainVerification polls the domain with exponential backoff until it was verified.
+// It gives up if the challenge of the domain expires or if the context is done. In both cases
+// the last domain received from the API is returned together with the error.
+func (c *Client) WaitForDomainVerification(ctx context.Context, domainId string, options WaitOptions) (*DomainResponse, error) {
+	return c.pollDomain(ctx, domainId, options, func(d *DomainResponse) (bool, error) {
+		if d.Verification != nil && d.Verification.Verified {
+			return true, nil
+		}
+		if challengeExpired(d, time.Now()) {
+			return true, ErrDomainChallengeExpired
+		}
+		return false, nil
+	})
+}
+
+// WaitForDomainVerificationResult polls the domain with exponential backoff until the API
+// finished checking the domain challenge, no matter whether the check succeeded or not.
+func (c *Client) WaitForDomainVerificationResult(ctx context.Context, domainId string, options WaitOptions) (*DomainResponse, error) {
+	return c.pollDomain(ctx, domainId, options, func(d *DomainResponse) (bool, error) {
+		return !d.Verification.Pending(), nil
+	})
+}
+
+func (c *Client) pollDomain(ctx context.Context, domainId string, options WaitOptions, done func(d *DomainResponse) (bool, error)) (*DomainResponse, error) {
+	backoff := RetryOptions{MinWait: options.MinWait, MaxWait: options.MaxWait}
+
+	for attempt := 0; ; attempt++ {
+		d, err := c.GetDomain(ctx, domainId)
+		if err != nil {
+			return nil, err
+		}
+
+		if options.Progress != nil {
+			options.Progress(d)
+		}
+
+		if ok, err := done(d); ok {
+			return d, err
+		}
+
+		wait := backoff.backoff(attempt, nil)
+		tflog.Debug(ctx, "Waiting for domain verification", map[string]any{
+			"id":      domainId,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		})
+
+		if err := sleep(ctx, wait); err != nil {
+			return d, fmt.Errorf("domain %s was not verified in time: %w", domainId, err)
+		}
+	}
+}
+
+// challengeExpired returns true if the challenge of the domain has an expiry date
+// in milliseconds that lies in the past.
+func challengeExpired(d *DomainResponse, now time.Time) bool {
+	expiresAt := d.Challenge.ExpiresAt()
+	if expiresAt <= 0 {
+		return false
+	}
+	return now.UnixMilli() > expiresAt
+}
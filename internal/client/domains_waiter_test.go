@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWaitForDomainVerification(t *testing.T) {
+	t.Parallel()
+
+	expired := time.Now().Add(-time.Minute).UnixMilli()
+	valid := time.Now().Add(time.Hour).UnixMilli()
+
+	type testCase struct {
+		verifiedAfter  int32
+		expiresAt      int64
+		dns            bool
+		timeout        time.Duration
+		expectAttempts int32
+		expectError    error
+	}
+	tests := map[string]testCase{
+		"already verified": {
+			verifiedAfter:  1,
+			expiresAt:      valid,
+			expectAttempts: 1,
+		},
+		"verified after polling": {
+			verifiedAfter:  3,
+			expiresAt:      valid,
+			expectAttempts: 3,
+		},
+		"verified without expiry date": {
+			verifiedAfter:  2,
+			expectAttempts: 2,
+		},
+		"challenge expired": {
+			verifiedAfter:  5,
+			expiresAt:      expired,
+			expectAttempts: 1,
+			expectError:    ErrDomainChallengeExpired,
+		},
+		"dns challenge expired": {
+			verifiedAfter:  5,
+			expiresAt:      expired,
+			dns:            true,
+			expectAttempts: 1,
+			expectError:    ErrDomainChallengeExpired,
+		},
+		"timeout": {
+			verifiedAfter: 1000,
+			expiresAt:     valid,
+			timeout:       50 * time.Millisecond,
+			expectError:   context.DeadlineExceeded,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := attempts.Add(1)
+				domain := DomainResponse{
+					Id:           "domain",
+					Challenge:    &DomainChallenge{Https: &HttpsDomainChallenge{ExpiresAt: test.expiresAt}},
+					Verification: &DomainVerification{Verified: attempt >= test.verifiedAfter},
+				}
+				if test.dns {
+					domain.Challenge = &DomainChallenge{Dns: &DnsDomainChallenge{ExpiresAt: test.expiresAt}}
+				}
+				_ = json.NewEncoder(w).Encode(map[string]any{"domain": domain})
+			}))
+			defer server.Close()
+
+			ctx := context.Background()
+			if test.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.timeout)
+				defer cancel()
+			}
+
+			apiKey := "test"
+			c, _ := NewClient(server.URL, &apiKey)
+			d, err := c.WaitForDomainVerification(ctx, "domain", WaitOptions{
+				MinWait: time.Millisecond,
+				MaxWait: 5 * time.Millisecond,
+			})
+
+			if test.expectError == nil && err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+			if test.expectError != nil && !errors.Is(err, test.expectError) {
+				t.Fatalf("expected error %s, got %v", test.expectError, err)
+			}
+			if test.expectError == nil && !d.Verification.Verified {
+				t.Fatal("expected domain to be verified")
+			}
+			if test.expectAttempts > 0 && attempts.Load() != test.expectAttempts {
+				t.Fatalf("expected %d attempts, got %d", test.expectAttempts, attempts.Load())
+			}
+		})
+	}
+}
+
+func TestWaitForDomainVerificationResult(t *testing.T) {
+	t.Parallel()
+
+	statuses := []string{DomainVerificationPending, DomainVerificationPending, DomainVerificationFailed}
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt := attempts.Add(1)
+		domain := DomainResponse{
+			Id:           "domain",
+			Verification: &DomainVerification{Status: statuses[attempt-1]},
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"domain": domain})
+	}))
+	defer server.Close()
+
+	var progress []string
+	apiKey := "test"
+	c, _ := NewClient(server.URL, &apiKey)
+	d, err := c.WaitForDomainVerificationResult(context.Background(), "domain", WaitOptions{
+		MinWait: time.Millisecond,
+		MaxWait: 5 * time.Millisecond,
+		Progress: func(d *DomainResponse) {
+			progress = append(progress, d.Verification.Status)
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+	if d.Verification.Status != DomainVerificationFailed {
+		t.Fatalf("expected verification to have failed, got %s", d.Verification.Status)
+	}
+	if len(progress) != len(statuses) {
+		t.Fatalf("expected %d progress updates, got %d", len(statuses), len(progress))
+	}
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const requestIdHeaderName string = "x-request-id"
+
+// APIError describes a response of the discue API that did not have the expected status code.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Method     string
+	Path       string
+	RequestId  string
+	Body       []byte
+}
+
+// apiErrorBody is the JSON representation of errors returned by the discue API.
+type apiErrorBody struct {
+	Code    string `json:"code"`
+	Error   string `json:"error"`
+	Title   string `json:"title"`
+	Message string `json:"message"`
+	Detail  string `json:"detail"`
+}
+
+func newAPIError(res *http.Response, requestOptions RequestOptions, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		Method:     requestOptions.Method,
+		Path:       requestOptions.Path,
+		RequestId:  res.Header.Get(requestIdHeaderName),
+		Body:       body,
+	}
+
+	var parsed apiErrorBody
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		apiErr.Code = firstNonEmpty(parsed.Code, parsed.Error, parsed.Title)
+		apiErr.Message = firstNonEmpty(parsed.Message, parsed.Detail, parsed.Title)
+	} else {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s returned status %d", e.Method, e.Path, e.StatusCode)
+	if e.Code != "" {
+		fmt.Fprintf(&sb, " (%s)", e.Code)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&sb, ": %s", e.Message)
+	}
+	if e.RequestId != "" {
+		fmt.Fprintf(&sb, " [request id: %s]", e.RequestId)
+	}
+	return sb.String()
+}
+
+// IsNotFound returns true if err was caused by a 404 response of the API.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict returns true if err was caused by a 409 response of the API.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsUnauthorized returns true if err was caused by a 401 or 403 response of the API.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized) || hasStatus(err, http.StatusForbidden)
+}
+
+// IsRateLimited returns true if err was caused by a 429 response of the API.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == status
+	}
+	return false
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
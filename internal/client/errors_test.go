@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		status          int
+		body            string
+		expectCode      string
+		expectMessage   string
+		expectNotFound  bool
+		expectConflict  bool
+		expectUnauth    bool
+		expectRateLimit bool
+	}
+	tests := map[string]testCase{
+		"not found": {
+			status:         http.StatusNotFound,
+			body:           `{"code":"not_found","message":"queue does not exist"}`,
+			expectCode:     "not_found",
+			expectMessage:  "queue does not exist",
+			expectNotFound: true,
+		},
+		"conflict": {
+			status:         http.StatusConflict,
+			body:           `{"title":"Conflict","detail":"alias already taken"}`,
+			expectCode:     "Conflict",
+			expectMessage:  "alias already taken",
+			expectConflict: true,
+		},
+		"unauthorized": {
+			status:       http.StatusUnauthorized,
+			body:         `{"error":"unauthorized"}`,
+			expectCode:   "unauthorized",
+			expectUnauth: true,
+		},
+		"forbidden": {
+			status:       http.StatusForbidden,
+			body:         `{"code":"forbidden"}`,
+			expectCode:   "forbidden",
+			expectUnauth: true,
+		},
+		"rate limited": {
+			status:          http.StatusTooManyRequests,
+			body:            "slow down",
+			expectMessage:   "slow down",
+			expectRateLimit: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set(requestIdHeaderName, "abc")
+				w.WriteHeader(test.status)
+				_, _ = fmt.Fprint(w, test.body)
+			}))
+			defer server.Close()
+
+			apiKey := "test"
+			c, _ := NewClient(server.URL, &apiKey, WithRetryOptions(RetryOptions{MaxRetries: 0}))
+
+			_, err := c.GetQueue(context.TODO(), "123")
+			if err == nil {
+				t.Fatal("expected error, got no error")
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *APIError, got %T", err)
+			}
+			if apiErr.StatusCode != test.status {
+				t.Errorf("expected status %d, got %d", test.status, apiErr.StatusCode)
+			}
+			if apiErr.Code != test.expectCode {
+				t.Errorf("expected code %q, got %q", test.expectCode, apiErr.Code)
+			}
+			if apiErr.Message != test.expectMessage {
+				t.Errorf("expected message %q, got %q", test.expectMessage, apiErr.Message)
+			}
+			if apiErr.Method != http.MethodGet || apiErr.Path != "/queues/123" || apiErr.RequestId != "abc" {
+				t.Errorf("unexpected request details %s %s %s", apiErr.Method, apiErr.Path, apiErr.RequestId)
+			}
+
+			wrapped := fmt.Errorf("wrapped: %w", err)
+			if IsNotFound(wrapped) != test.expectNotFound {
+				t.Errorf("IsNotFound returned %t", !test.expectNotFound)
+			}
+			if IsConflict(wrapped) != test.expectConflict {
+				t.Errorf("IsConflict returned %t", !test.expectConflict)
+			}
+			if IsUnauthorized(wrapped) != test.expectUnauth {
+				t.Errorf("IsUnauthorized returned %t", !test.expectUnauth)
+			}
+			if IsRateLimited(wrapped) != test.expectRateLimit {
+				t.Errorf("IsRateLimited returned %t", !test.expectRateLimit)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -8,17 +9,27 @@ import (
 const singleListenerResponseKey string = "listener"
 const listenersPathName string = singleListenerResponseKey + "s"
 
-func (c *Client) GetListener(queueId string, listenerId string) (*ListenerResponse, error) {
+func (c *Client) GetListener(ctx context.Context, queueId string, listenerId string) (*ListenerResponse, error) {
 	requestOptions := RequestOptions{
 		Method:       http.MethodGet,
 		Path:         fmt.Sprintf("/queues/%s/%s/%s", queueId, listenersPathName, listenerId),
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[ListenerResponse](c, requestOptions, singleListenerResponseKey)
+	return sendAndReceive[ListenerResponse](ctx, c, requestOptions, singleListenerResponseKey)
 }
 
-func (c *Client) CreateListener(queueId string, newListener ListenerRequest) (*ListenerResponse, error) {
+func (c *Client) ListListeners(ctx context.Context, queueId string) ([]ListenerResponse, error) {
+	requestOptions := RequestOptions{
+		Method:       http.MethodGet,
+		Path:         fmt.Sprintf("/queues/%s/%s", queueId, listenersPathName),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return collectPages(sendAndReceivePages[ListenerResponse](ctx, c, requestOptions, listenersPathName))
+}
+
+func (c *Client) CreateListener(ctx context.Context, queueId string, newListener ListenerRequest) (*ListenerResponse, error) {
 	requestOptions := RequestOptions{
 		Body:         newListener,
 		Method:       http.MethodPost,
@@ -26,10 +37,10 @@ func (c *Client) CreateListener(queueId string, newListener ListenerRequest) (*L
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[ListenerResponse](c, requestOptions, singleListenerResponseKey)
+	return sendAndReceive[ListenerResponse](ctx, c, requestOptions, singleListenerResponseKey)
 }
 
-func (c *Client) UpdateListener(queueId string, listenerId string, updatedListener ListenerRequest) (*ListenerResponse, error) {
+func (c *Client) UpdateListener(ctx context.Context, queueId string, listenerId string, updatedListener ListenerRequest) (*ListenerResponse, error) {
 	requestOptions := RequestOptions{
 		Body:         updatedListener,
 		Method:       http.MethodPut,
@@ -37,15 +48,15 @@ func (c *Client) UpdateListener(queueId string, listenerId string, updatedListen
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[ListenerResponse](c, requestOptions, singleListenerResponseKey)
+	return sendAndReceive[ListenerResponse](ctx, c, requestOptions, singleListenerResponseKey)
 }
 
-func (c *Client) DeleteListener(queueId string, listenerId string) (*ListenerResponse, error) {
+func (c *Client) DeleteListener(ctx context.Context, queueId string, listenerId string) (*ListenerResponse, error) {
 	requestOptions := RequestOptions{
 		Method:       http.MethodDelete,
 		Path:         fmt.Sprintf("/queues/%s/%s/%s", queueId, listenersPathName, listenerId),
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[ListenerResponse](c, requestOptions, "_links") // because delete requests will not have an entity in the response
+	return sendAndReceive[ListenerResponse](ctx, c, requestOptions, "_links") // because delete requests will not have an entity in the response
 }
@@ -1,11 +1,56 @@
 package client
 
+const (
+	ListenerDeliveryBackoffFixed       = "fixed"
+	ListenerDeliveryBackoffExponential = "exponential"
+)
+
+const (
+	ListenerLivenessResultSuccess = "success"
+	ListenerLivenessResultFailure = "failure"
+)
+
 type Listener struct {
 	Id          string `json:"id,omitempty"`
 	Alias       string `json:"alias"`
 	Status      string `json:"status,omitempty"`
 	NotifyUrl   string `json:"notify_url,omitempty"`
 	LivenessUrl string `json:"liveness_url,omitempty"`
+	// sent as null to reset the delivery policy to the defaults of the API
+	Delivery *ListenerDelivery `json:"delivery"`
+	// only sent to set a new secret, the API never returns the secret
+	SigningSecret string `json:"signing_secret,omitempty"`
+	// the date time in milliseconds the signing secret was last set
+	SigningSecretUpdatedAt int64 `json:"signing_secret_updated_at,omitempty"`
+	// sent as null to reset the liveness probe to the defaults of the API
+	Liveness *ListenerLiveness `json:"liveness"`
+	// only returned by the API, nil until the liveness url was probed for the first time
+	LivenessState *ListenerLivenessState `json:"liveness_state,omitempty"`
+}
+
+// ListenerDelivery configures how messages are sent to the notify url of a listener.
+type ListenerDelivery struct {
+	Headers     map[string]string `json:"headers,omitempty"`
+	TimeoutMs   *int64            `json:"timeout_ms,omitempty"`
+	MaxAttempts *int64            `json:"max_attempts,omitempty"`
+	Backoff     *string           `json:"backoff,omitempty"`
+}
+
+// ListenerLiveness configures how the liveness url of a listener is probed.
+type ListenerLiveness struct {
+	IntervalSeconds  *int64 `json:"interval_seconds,omitempty"`
+	TimeoutMs        *int64 `json:"timeout_ms,omitempty"`
+	FailureThreshold *int64 `json:"failure_threshold,omitempty"`
+	ExpectedStatus   *int64 `json:"expected_status,omitempty"`
+}
+
+// ListenerLivenessState is the result of the latest probes of the liveness url of a listener.
+type ListenerLivenessState struct {
+	// the date time in milliseconds of the last probe
+	LastProbeAt         int64  `json:"last_probe_at"`
+	LastResult          string `json:"last_result"`
+	ConsecutiveFailures int64  `json:"consecutive_failures"`
+	Live                bool   `json:"live"`
 }
 
 type ListenerRequest = Listener
@@ -1,11 +1,20 @@
 package client
 
-import "net/http"
+import (
+	"encoding/json"
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
 
 type Client struct {
-	ApiEndpoint string
-	ApiKey      string
-	HttpClient  *http.Client
+	ApiEndpoint  string
+	ApiKey       string
+	HttpClient   *http.Client
+	RetryOptions RetryOptions
+
+	limiter   *rate.Limiter
+	semaphore chan struct{}
 }
 
 type RequestOptions struct {
@@ -18,25 +27,71 @@ type RequestOptions struct {
 type Queue struct {
 	Id    string `json:"id,omitempty"`
 	Alias string `json:"alias"`
+	// unset settings are sent as null, the API then fills in and returns its defaults.
+	// The dead letter queue has no default, null detaches it.
+	MessageRetentionSeconds *int64  `json:"message_retention_seconds"`
+	MaxDeliveryAttempts     *int64  `json:"max_delivery_attempts"`
+	RetryBackoffSeconds     *int64  `json:"retry_backoff_seconds"`
+	DeadLetterQueueId       *string `json:"dead_letter_queue_id"`
+}
+
+type Channel struct {
+	Id       string  `json:"id,omitempty"`
+	Alias    string  `json:"alias"`
+	DomainId *string `json:"domain_id"`
+	Path     *string `json:"path"`
+}
+
+type Schema struct {
+	Id         string          `json:"id,omitempty"`
+	Alias      string          `json:"alias"`
+	Definition json.RawMessage `json:"definition"`
+}
+
+type Topic struct {
+	Id    string `json:"id,omitempty"`
+	Alias string `json:"alias"`
 }
 
+const (
+	DomainChallengeTypeHttps = "https"
+	DomainChallengeTypeDns   = "dns"
+)
+
 type DomainRequest struct {
-	Alias    string `json:"alias"`
-	Hostname string `json:"hostname,omitempty"`
-	Port     int    `json:"port,omitempty"`
+	Alias         string `json:"alias"`
+	Hostname      string `json:"hostname,omitempty"`
+	Port          int    `json:"port,omitempty"`
+	ChallengeType string `json:"challenge_type,omitempty"`
 }
 
 type DomainResponse struct {
-	Id           string              `json:"id"`
-	Alias        string              `json:"alias"`
-	Hostname     string              `json:"hostname"`
-	Port         int32               `json:"port"`
-	Challenge    *DomainChallenge    `json:"challenge"`
-	Verification *DomainVerification `json:"verification"`
+	Id            string              `json:"id"`
+	Alias         string              `json:"alias"`
+	Hostname      string              `json:"hostname"`
+	Port          int32               `json:"port"`
+	ChallengeType string              `json:"challenge_type"`
+	Challenge     *DomainChallenge    `json:"challenge"`
+	Verification  *DomainVerification `json:"verification"`
 }
 
+// DomainChallenge contains the challenge matching the challenge type of the domain, the other one is nil.
 type DomainChallenge struct {
-	Https HttpsDomainChallenge `json:"https"`
+	Https *HttpsDomainChallenge `json:"https,omitempty"`
+	Dns   *DnsDomainChallenge   `json:"dns,omitempty"`
+}
+
+// ExpiresAt returns the expiry date in milliseconds of the challenge, or 0 if it does not expire.
+func (c *DomainChallenge) ExpiresAt() int64 {
+	switch {
+	case c == nil:
+		return 0
+	case c.Https != nil:
+		return c.Https.ExpiresAt
+	case c.Dns != nil:
+		return c.Dns.ExpiresAt
+	}
+	return 0
 }
 
 type HttpsDomainChallenge struct {
@@ -47,9 +102,30 @@ type HttpsDomainChallenge struct {
 	ExpiresAt   int64  `json:"expires_at"`
 }
 
+type DnsDomainChallenge struct {
+	RecordName  string `json:"record_name"`
+	RecordType  string `json:"record_type"`
+	RecordValue string `json:"record_value"`
+	CreatedAt   int64  `json:"created_at"`
+	ExpiresAt   int64  `json:"expires_at"`
+}
+
+const (
+	DomainVerificationPending  = "pending"
+	DomainVerificationVerified = "verified"
+	DomainVerificationFailed   = "failed"
+)
+
 type DomainVerification struct {
-	Verified   bool  `json:"verified,omitempty"`
-	VerifiedAt int64 `json:"verified_at,omitempty"`
+	Verified   bool   `json:"verified,omitempty"`
+	VerifiedAt int64  `json:"verified_at,omitempty"`
+	Status     string `json:"status,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// Pending returns true while the API is still checking the domain challenge.
+func (v *DomainVerification) Pending() bool {
+	return v != nil && v.Status == DomainVerificationPending
 }
 
 type ApiResponse[T any] struct {
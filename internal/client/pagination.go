@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"strings"
+)
+
+// halLinks describes the HAL links the API sends along with list responses.
+type halLinks struct {
+	Next *struct {
+		Href string `json:"href"`
+	} `json:"next,omitempty"`
+}
+
+// sendAndReceivePages returns an iterator over all elements of a paginated list endpoint. The elements
+// are expected in the array with the given jsonKey. Further pages are requested as long as the response
+// contains a `_links.next` reference.
+func sendAndReceivePages[T any](ctx context.Context, c *Client, requestOptions RequestOptions, jsonKey string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		visited := map[string]bool{}
+
+		for requestOptions.Path != "" {
+			if visited[requestOptions.Path] {
+				yield(zero, fmt.Errorf("pagination of %s returned the same page twice", requestOptions.Path))
+				return
+			}
+			visited[requestOptions.Path] = true
+
+			body, err := c.executeRequest(ctx, requestOptions)
+			if err != nil {
+				yield(zero, fmt.Errorf("could not execute request: %w", err))
+				return
+			}
+
+			var rawMap map[string]json.RawMessage
+			err = json.Unmarshal(body, &rawMap)
+			if err != nil {
+				yield(zero, fmt.Errorf("could not unmarshal response body: %w", err))
+				return
+			}
+
+			rawResponse, ok := rawMap[jsonKey]
+			if !ok {
+				yield(zero, fmt.Errorf("could not unmarshal resources from response: %s", rawMap))
+				return
+			}
+
+			var elements []T
+			err = json.Unmarshal(rawResponse, &elements)
+			if err != nil {
+				yield(zero, fmt.Errorf("could not unmarshal response: %w %s", err, rawResponse))
+				return
+			}
+
+			for _, element := range elements {
+				if !yield(element, nil) {
+					return
+				}
+			}
+
+			var links halLinks
+			if rawLinks, ok := rawMap["_links"]; ok {
+				err = json.Unmarshal(rawLinks, &links)
+				if err != nil {
+					yield(zero, fmt.Errorf("could not unmarshal links: %w %s", err, rawLinks))
+					return
+				}
+			}
+
+			requestOptions.Path = ""
+			if links.Next != nil && links.Next.Href != "" {
+				requestOptions.Path, err = c.relativePath(links.Next.Href)
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+			}
+		}
+	}
+}
+
+// collectPages reads all elements of a paginated list endpoint.
+func collectPages[T any](pages iter.Seq2[T, error]) ([]T, error) {
+	result := []T{}
+	for element, err := range pages {
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, element)
+	}
+	return result, nil
+}
+
+// relativePath converts a HAL link into a path relative to the API endpoint of the client.
+func (c *Client) relativePath(href string) (string, error) {
+	href = strings.TrimPrefix(href, c.ApiEndpoint)
+
+	link, err := url.Parse(href)
+	if err != nil {
+		return "", fmt.Errorf("could not parse link %s: %w", href, err)
+	}
+
+	if link.IsAbs() {
+		endpoint, err := url.Parse(c.ApiEndpoint)
+		if err != nil || endpoint.Host != link.Host {
+			return "", fmt.Errorf("link %s does not point to the API endpoint %s", href, c.ApiEndpoint)
+		}
+		link.Path = strings.TrimPrefix(link.Path, endpoint.Path)
+	}
+
+	path := link.EscapedPath()
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	if link.RawQuery != "" {
+		path = path + "?" + link.RawQuery
+	}
+	return path, nil
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListQueuesFollowsNextLinks(t *testing.T) {
+	t.Parallel()
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "":
+			_, _ = fmt.Fprint(w, `{"queues":[{"id":"1","alias":"a"},{"id":"2","alias":"b"}],"_links":{"next":{"href":"/queues?page=2"}}}`)
+		case "2":
+			_, _ = fmt.Fprintf(w, `{"queues":[{"id":"3","alias":"c"}],"_links":{"next":{"href":"%s/queues?page=3"}}}`, server.URL)
+		case "3":
+			_, _ = fmt.Fprint(w, `{"queues":[],"_links":{"self":{"href":"/queues?page=3"}}}`)
+		}
+	}))
+	defer server.Close()
+
+	apiKey := "test"
+	c, _ := NewClient(server.URL, &apiKey)
+
+	queues, err := c.ListQueues(context.TODO())
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	if len(queues) != 3 {
+		t.Fatalf("expected 3 queues, got %d", len(queues))
+	}
+	for i, alias := range []string{"a", "b", "c"} {
+		if queues[i].Alias != alias {
+			t.Errorf("expected alias %s at index %d, got %s", alias, i, queues[i].Alias)
+		}
+	}
+}
+
+func TestListQueuesDetectsPaginationLoop(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		_, _ = fmt.Fprint(w, `{"queues":[{"id":"1","alias":"a"}],"_links":{"next":{"href":"/queues"}}}`)
+	}))
+	defer server.Close()
+
+	apiKey := "test"
+	c, _ := NewClient(server.URL, &apiKey)
+
+	_, err := c.ListQueues(context.TODO())
+	if err == nil {
+		t.Fatal("expected error, got no error")
+	}
+}
+
+func TestRelativePath(t *testing.T) {
+	t.Parallel()
+
+	c := Client{ApiEndpoint: "https://api.discue.io/v1"}
+
+	type testCase struct {
+		href        string
+		expectPath  string
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"relative path":         {href: "/queues?page=2", expectPath: "/queues?page=2"},
+		"absolute url":          {href: "https://api.discue.io/v1/queues?page=2", expectPath: "/queues?page=2"},
+		"path without slash":    {href: "queues", expectPath: "/queues"},
+		"url of foreign domain": {href: "https://discue.io/queues", expectError: true},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			path, err := c.relativePath(test.href)
+			if err == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+			if err != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+			if path != test.expectPath {
+				t.Fatalf("expected path %s, got %s", test.expectPath, path)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -8,17 +9,27 @@ import (
 const queuesResourceName string = "queues"
 const singleQueueResponseKey string = "queue"
 
-func (c *Client) GetQueue(queueId string) (*Queue, error) {
+func (c *Client) GetQueue(ctx context.Context, queueId string) (*Queue, error) {
 	requestOptions := RequestOptions{
 		Method:       http.MethodGet,
 		Path:         fmt.Sprintf("/%s/%s", queuesResourceName, queueId),
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[Queue](c, requestOptions, singleQueueResponseKey)
+	return sendAndReceive[Queue](ctx, c, requestOptions, singleQueueResponseKey)
 }
 
-func (c *Client) CreateQueue(newQueue Queue) (*Queue, error) {
+func (c *Client) ListQueues(ctx context.Context) ([]Queue, error) {
+	requestOptions := RequestOptions{
+		Method:       http.MethodGet,
+		Path:         fmt.Sprintf("/%s", queuesResourceName),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return collectPages(sendAndReceivePages[Queue](ctx, c, requestOptions, queuesResourceName))
+}
+
+func (c *Client) CreateQueue(ctx context.Context, newQueue Queue) (*Queue, error) {
 	requestOptions := RequestOptions{
 		Body:         newQueue,
 		Method:       http.MethodPost,
@@ -26,10 +37,10 @@ func (c *Client) CreateQueue(newQueue Queue) (*Queue, error) {
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[Queue](c, requestOptions, singleQueueResponseKey)
+	return sendAndReceive[Queue](ctx, c, requestOptions, singleQueueResponseKey)
 }
 
-func (c *Client) UpdateQueue(queueId string, updatedQueue Queue) (*Queue, error) {
+func (c *Client) UpdateQueue(ctx context.Context, queueId string, updatedQueue Queue) (*Queue, error) {
 	requestOptions := RequestOptions{
 		Body:         updatedQueue,
 		Method:       http.MethodPut,
@@ -37,15 +48,15 @@ func (c *Client) UpdateQueue(queueId string, updatedQueue Queue) (*Queue, error)
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[Queue](c, requestOptions, singleQueueResponseKey)
+	return sendAndReceive[Queue](ctx, c, requestOptions, singleQueueResponseKey)
 }
 
-func (c *Client) DeleteQueue(queueId string) (*Queue, error) {
+func (c *Client) DeleteQueue(ctx context.Context, queueId string) (*Queue, error) {
 	requestOptions := RequestOptions{
 		Method:       http.MethodDelete,
 		Path:         fmt.Sprintf("/%s/%s", queuesResourceName, queueId),
 		ExpectStatus: http.StatusOK,
 	}
 
-	return sendAndReceive[Queue](c, requestOptions, "_links") // because delete requests will not have an entity in the response
+	return sendAndReceive[Queue](ctx, c, requestOptions, "_links") // because delete requests will not have an entity in the response
 }
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"math"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimitOptions describe how many requests the client is allowed to send. All resources
+// share the same client so the limits apply to the whole provider process.
+type RateLimitOptions struct {
+	// RequestsPerSecond limits the rate of requests. Zero disables the limit.
+	RequestsPerSecond float64
+	// MaxConcurrentRequests limits the number of requests in flight. Zero disables the limit.
+	MaxConcurrentRequests int
+}
+
+// WithRateLimitOptions limits the rate and concurrency of requests sent by the client.
+func WithRateLimitOptions(rateLimitOptions RateLimitOptions) ClientOption {
+	return func(c *Client) {
+		c.limiter = nil
+		if rateLimitOptions.RequestsPerSecond > 0 {
+			burst := int(math.Max(1, math.Floor(rateLimitOptions.RequestsPerSecond)))
+			c.limiter = rate.NewLimiter(rate.Limit(rateLimitOptions.RequestsPerSecond), burst)
+		}
+
+		c.semaphore = nil
+		if rateLimitOptions.MaxConcurrentRequests > 0 {
+			c.semaphore = make(chan struct{}, rateLimitOptions.MaxConcurrentRequests)
+		}
+	}
+}
+
+// acquire blocks until the request is allowed to be sent. The returned function must be
+// called once the request completed.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+
+	if c.semaphore != nil {
+		select {
+		case c.semaphore <- struct{}{}:
+			release = func() { <-c.semaphore }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	apiKey := "test"
+	c, _ := NewClient(server.URL, &apiKey, WithRateLimitOptions(RateLimitOptions{MaxConcurrentRequests: 2}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = c.executeRequest(context.TODO(), RequestOptions{Method: http.MethodGet, Path: "/queues", ExpectStatus: http.StatusOK})
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight.Load() > 2 {
+		t.Fatalf("expected at most 2 concurrent requests, got %d", maxInFlight.Load())
+	}
+}
+
+func TestRequestsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	apiKey := "test"
+	c, _ := NewClient(server.URL, &apiKey, WithRateLimitOptions(RateLimitOptions{RequestsPerSecond: 20}))
+
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		_, err := c.executeRequest(context.TODO(), RequestOptions{Method: http.MethodGet, Path: "/queues", ExpectStatus: http.StatusOK})
+		if err != nil {
+			t.Fatalf("got unexpected error: %s", err)
+		}
+	}
+
+	// the first 20 requests are covered by the burst, the remaining 10 need at least 500ms
+	if elapsed := time.Since(start); elapsed < 450*time.Millisecond {
+		t.Fatalf("expected requests to be rate limited, took only %s", elapsed)
+	}
+}
+
+func TestRateLimitHonorsContext(t *testing.T) {
+	t.Parallel()
+
+	apiKey := "test"
+	c, _ := NewClient("http://localhost:0", &apiKey, WithRateLimitOptions(RateLimitOptions{MaxConcurrentRequests: 1}))
+	c.semaphore <- struct{}{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := c.executeRequest(ctx, RequestOptions{Method: http.MethodGet, Path: "/queues", ExpectStatus: http.StatusOK})
+	if err == nil {
+		t.Fatal("expected error, got no error")
+	}
+}
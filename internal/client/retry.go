@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RetryOptions describe how often and how long to wait before failed requests are sent again.
+type RetryOptions struct {
+	MaxRetries int
+	MinWait    time.Duration
+	MaxWait    time.Duration
+}
+
+func DefaultRetryOptions() RetryOptions {
+	return RetryOptions{
+		MaxRetries: 3,
+		MinWait:    1 * time.Second,
+		MaxWait:    30 * time.Second,
+	}
+}
+
+// WithRetryOptions overrides the default retry behavior of the client.
+func WithRetryOptions(retryOptions RetryOptions) ClientOption {
+	return func(c *Client) {
+		c.RetryOptions = retryOptions
+	}
+}
+
+// shouldRetry decides whether a failed request can be sent again. Requests that are not
+// idempotent are only retried if the API did certainly not process them.
+func shouldRetry(method string, res *http.Response, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if res == nil {
+		if isDialError(err) {
+			return true
+		}
+		return isIdempotent(method) && isConnectionReset(err)
+	}
+
+	if res.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	if res.StatusCode >= 500 && res.StatusCode != http.StatusNotImplemented {
+		// a 503 with Retry-After signals that the request was rejected before being processed
+		if res.StatusCode == http.StatusServiceUnavailable && res.Header.Get("retry-after") != "" {
+			return true
+		}
+		return isIdempotent(method)
+	}
+
+	return false
+}
+
+func isIdempotent(method string) bool {
+	return method != http.MethodPost && method != http.MethodPatch
+}
+
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func isConnectionReset(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// backoff returns the time to wait before the next attempt. A Retry-After header sent by the
+// API takes precedence over the exponential backoff, but never exceeds MaxWait.
+func (o RetryOptions) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := parseRetryAfter(res.Header.Get("retry-after"), time.Now()); ok {
+			return min(wait, o.MaxWait)
+		}
+	}
+
+	wait := o.MinWait << attempt
+	if wait <= 0 || wait > o.MaxWait {
+		wait = o.MaxWait
+	}
+
+	// add jitter to prevent all parallel requests from retrying at the same time
+	half := wait / 2
+	if half <= 0 {
+		return wait
+	}
+	return half + rand.N(half+1)
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or a http date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := date.Sub(now)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+func sleep(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func logRetry(ctx context.Context, requestOptions RequestOptions, attempt int, wait time.Duration, err error) {
+	tflog.Warn(ctx, "Retrying request to discue API", map[string]any{
+		"method":  requestOptions.Method,
+		"path":    requestOptions.Path,
+		"attempt": attempt,
+		"wait":    wait.String(),
+		"error":   err.Error(),
+	})
+}
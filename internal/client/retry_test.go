@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		method         string
+		statuses       []int
+		retryAfter     string
+		expectAttempts int32
+		expectError    bool
+	}
+	tests := map[string]testCase{
+		"no retry on success": {
+			method:         http.MethodGet,
+			statuses:       []int{http.StatusOK},
+			expectAttempts: 1,
+		},
+		"retry GET on 502": {
+			method:         http.MethodGet,
+			statuses:       []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusOK},
+			expectAttempts: 3,
+		},
+		"retry POST on 429": {
+			method:         http.MethodPost,
+			statuses:       []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:     "0",
+			expectAttempts: 2,
+		},
+		"no retry of POST on 502": {
+			method:         http.MethodPost,
+			statuses:       []int{http.StatusBadGateway, http.StatusOK},
+			expectAttempts: 1,
+			expectError:    true,
+		},
+		"no retry on 404": {
+			method:         http.MethodGet,
+			statuses:       []int{http.StatusNotFound, http.StatusOK},
+			expectAttempts: 1,
+			expectError:    true,
+		},
+		"give up after max retries": {
+			method:         http.MethodDelete,
+			statuses:       []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			expectAttempts: 3,
+			expectError:    true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := attempts.Add(1)
+				if test.retryAfter != "" {
+					w.Header().Set("retry-after", test.retryAfter)
+				}
+				w.WriteHeader(test.statuses[attempt-1])
+			}))
+			defer server.Close()
+
+			apiKey := "test"
+			c, _ := NewClient(server.URL, &apiKey, WithRetryOptions(RetryOptions{
+				MaxRetries: 2,
+				MinWait:    time.Millisecond,
+				MaxWait:    5 * time.Millisecond,
+			}))
+
+			_, err := c.executeRequest(context.TODO(), RequestOptions{
+				Method:       test.method,
+				Path:         "/queues",
+				ExpectStatus: http.StatusOK,
+			})
+
+			if err == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+			if err != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+			if attempts.Load() != test.expectAttempts {
+				t.Fatalf("expected %d attempts, got %d", test.expectAttempts, attempts.Load())
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		value      string
+		expectWait time.Duration
+		expectOk   bool
+	}
+	tests := map[string]testCase{
+		"empty":    {value: ""},
+		"invalid":  {value: "soon"},
+		"negative": {value: "-1"},
+		"seconds":  {value: "7", expectWait: 7 * time.Second, expectOk: true},
+		"date":     {value: now.Add(90 * time.Second).Format(http.TimeFormat), expectWait: 90 * time.Second, expectOk: true},
+		"past":     {value: now.Add(-time.Minute).Format(http.TimeFormat), expectWait: 0, expectOk: true},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			wait, ok := parseRetryAfter(test.value, now)
+			if ok != test.expectOk || wait != test.expectWait {
+				t.Fatalf("expected %s/%t, got %s/%t", test.expectWait, test.expectOk, wait, ok)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	options := RetryOptions{MinWait: time.Second, MaxWait: 10 * time.Second}
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := options.backoff(attempt, nil)
+		if wait < options.MinWait/2 || wait > options.MaxWait {
+			t.Fatalf("backoff of attempt %d out of bounds: %s", attempt, wait)
+		}
+	}
+}
+
+func TestBackoff_retryAfterExceedsMaxWait(t *testing.T) {
+	t.Parallel()
+
+	options := RetryOptions{MinWait: time.Second, MaxWait: 10 * time.Second}
+	for _, retryAfter := range []string{"3600", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)} {
+		res := &http.Response{Header: http.Header{}}
+		res.Header.Set("retry-after", retryAfter)
+		if wait := options.backoff(0, res); wait != options.MaxWait {
+			t.Fatalf("expected retry-after %s to be limited to %s, got %s", retryAfter, options.MaxWait, wait)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const schemasResourceName string = "schemas"
+const singleSchemaResponseKey string = "schema"
+
+func (c *Client) GetSchema(ctx context.Context, schemaId string) (*Schema, error) {
+	requestOptions := RequestOptions{
+		Method:       http.MethodGet,
+		Path:         fmt.Sprintf("/%s/%s", schemasResourceName, schemaId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[Schema](ctx, c, requestOptions, singleSchemaResponseKey)
+}
+
+func (c *Client) CreateSchema(ctx context.Context, newSchema Schema) (*Schema, error) {
+	requestOptions := RequestOptions{
+		Body:         newSchema,
+		Method:       http.MethodPost,
+		Path:         fmt.Sprintf("/%s", schemasResourceName),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[Schema](ctx, c, requestOptions, singleSchemaResponseKey)
+}
+
+func (c *Client) UpdateSchema(ctx context.Context, schemaId string, updatedSchema Schema) (*Schema, error) {
+	requestOptions := RequestOptions{
+		Body:         updatedSchema,
+		Method:       http.MethodPut,
+		Path:         fmt.Sprintf("/%s/%s", schemasResourceName, schemaId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[Schema](ctx, c, requestOptions, singleSchemaResponseKey)
+}
+
+func (c *Client) DeleteSchema(ctx context.Context, schemaId string) (*Schema, error) {
+	requestOptions := RequestOptions{
+		Method:       http.MethodDelete,
+		Path:         fmt.Sprintf("/%s/%s", schemasResourceName, schemaId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[Schema](ctx, c, requestOptions, "_links") // because delete requests will not have an entity in the response
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const singleSubscriptionResponseKey string = "subscription"
+const subscriptionsPathName string = singleSubscriptionResponseKey + "s"
+
+func (c *Client) GetSubscription(ctx context.Context, topicId string, subscriptionId string) (*SubscriptionResponse, error) {
+	requestOptions := RequestOptions{
+		Method:       http.MethodGet,
+		Path:         fmt.Sprintf("/topics/%s/%s/%s", topicId, subscriptionsPathName, subscriptionId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[SubscriptionResponse](ctx, c, requestOptions, singleSubscriptionResponseKey)
+}
+
+func (c *Client) CreateSubscription(ctx context.Context, topicId string, newSubscription SubscriptionRequest) (*SubscriptionResponse, error) {
+	requestOptions := RequestOptions{
+		Body:         newSubscription,
+		Method:       http.MethodPost,
+		Path:         fmt.Sprintf("/topics/%s/%s", topicId, subscriptionsPathName),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[SubscriptionResponse](ctx, c, requestOptions, singleSubscriptionResponseKey)
+}
+
+func (c *Client) UpdateSubscription(ctx context.Context, topicId string, subscriptionId string, updatedSubscription SubscriptionRequest) (*SubscriptionResponse, error) {
+	requestOptions := RequestOptions{
+		Body:         updatedSubscription,
+		Method:       http.MethodPut,
+		Path:         fmt.Sprintf("/topics/%s/%s/%s", topicId, subscriptionsPathName, subscriptionId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[SubscriptionResponse](ctx, c, requestOptions, singleSubscriptionResponseKey)
+}
+
+func (c *Client) DeleteSubscription(ctx context.Context, topicId string, subscriptionId string) (*SubscriptionResponse, error) {
+	requestOptions := RequestOptions{
+		Method:       http.MethodDelete,
+		Path:         fmt.Sprintf("/topics/%s/%s/%s", topicId, subscriptionsPathName, subscriptionId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[SubscriptionResponse](ctx, c, requestOptions, "_links") // because delete requests will not have an entity in the response
+}
@@ -0,0 +1,11 @@
+package client
+
+type Subscription struct {
+	Id      string   `json:"id,omitempty"`
+	Alias   string   `json:"alias"`
+	QueueId string   `json:"queue_id,omitempty"`
+	Filters []string `json:"filters"`
+}
+
+type SubscriptionRequest = Subscription
+type SubscriptionResponse = Subscription
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const topicsResourceName string = "topics"
+const singleTopicResponseKey string = "topic"
+
+func (c *Client) GetTopic(ctx context.Context, topicId string) (*Topic, error) {
+	requestOptions := RequestOptions{
+		Method:       http.MethodGet,
+		Path:         fmt.Sprintf("/%s/%s", topicsResourceName, topicId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[Topic](ctx, c, requestOptions, singleTopicResponseKey)
+}
+
+func (c *Client) CreateTopic(ctx context.Context, newTopic Topic) (*Topic, error) {
+	requestOptions := RequestOptions{
+		Body:         newTopic,
+		Method:       http.MethodPost,
+		Path:         fmt.Sprintf("/%s", topicsResourceName),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[Topic](ctx, c, requestOptions, singleTopicResponseKey)
+}
+
+func (c *Client) UpdateTopic(ctx context.Context, topicId string, updatedTopic Topic) (*Topic, error) {
+	requestOptions := RequestOptions{
+		Body:         updatedTopic,
+		Method:       http.MethodPut,
+		Path:         fmt.Sprintf("/%s/%s", topicsResourceName, topicId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[Topic](ctx, c, requestOptions, singleTopicResponseKey)
+}
+
+func (c *Client) DeleteTopic(ctx context.Context, topicId string) (*Topic, error) {
+	requestOptions := RequestOptions{
+		Method:       http.MethodDelete,
+		Path:         fmt.Sprintf("/%s/%s", topicsResourceName, topicId),
+		ExpectStatus: http.StatusOK,
+	}
+
+	return sendAndReceive[Topic](ctx, c, requestOptions, "_links") // because delete requests will not have an entity in the response
+}
@@ -0,0 +1,270 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-discue/internal/client"
+	v "terraform-provider-discue/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &apiClientResource{}
+var _ resource.ResourceWithConfigure = &apiClientResource{}
+var _ resource.ResourceWithImportState = &apiClientResource{}
+var _ resource.ResourceWithIdentity = &apiClientResource{}
+
+func NewApiClientResource() resource.Resource {
+	return &apiClientResource{}
+}
+
+type apiClientResource struct {
+	client *client.Client
+}
+
+type apiClientResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Alias        types.String `tfsdk:"alias"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Scopes       types.List   `tfsdk:"scopes"`
+}
+
+func (r *apiClientResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "api_client"}, "_")
+}
+
+func (r *apiClientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "API clients allow machines to authenticate with a client id and a client secret in exchange for short-lived access tokens. Like API keys, each API client can have specific scopes defined to limit access to other resources.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique id of the resource. Also used as client id.",
+				Validators: []validator.String{
+					v.ValidResourceId(""),
+				},
+			},
+			"alias": schema.StringAttribute{
+				Required:    true,
+				Description: "The name/alias of the resource. This should be unique.",
+				Validators: []validator.String{
+					v.ValidResourceAlias(""),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The secret of the API client. Only once after creation will the API return the secret. The provider keeps this value in state, later reads do not change it. The secret is not available for imported api clients. Marked as sensitive to prevent leakage.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scopes": scopesAttribute("API client"),
+		},
+	}
+}
+
+func (r *apiClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *apiClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan apiClientResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, err := r.convertToApiModel(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting api client to API model",
+			"Could not convert api client, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	c, err := r.client.CreateApiClient(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating api client via API",
+			"Could not create api client, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// the secret is only returned once
+	plan.ClientSecret = types.StringValue(c.ClientSecret)
+
+	c, err = r.client.GetApiClient(ctx, c.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading api client via API",
+			"Could not read api client, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.convertFromApiModel(c, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting api client received from API to internal model",
+			"Could not convert api client, unexpected error: "+err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *apiClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state apiClientResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := r.client.GetApiClient(ctx, state.Id.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Api client no longer exists, removing it from state", map[string]any{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading api client via API",
+			"Could not read api client, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.convertFromApiModel(c, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting api client received from API to internal model",
+			"Could not convert api client, unexpected error: "+err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *apiClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan apiClientResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state apiClientResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, err := r.convertToApiModel(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting api client to API model",
+			"Could not convert api client, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.client.UpdateApiClient(ctx, state.Id.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating api client via API",
+			"Could not update api client, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	c, err := r.client.GetApiClient(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading api client via API",
+			"Could not read api client, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.convertFromApiModel(c, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting api client received from API to internal model",
+			"Could not convert api client, unexpected error: "+err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *apiClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state apiClientResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DeleteApiClient(ctx, state.Id.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting api client via API",
+			"Could not delete api client, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *apiClientResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	idIdentitySchema(ctx, req, resp)
+}
+
+func (r *apiClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
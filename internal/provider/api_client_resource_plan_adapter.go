@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-discue/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *apiClientResource) convertFromApiModel(d *client.ApiClientResponse, plan *apiClientResourceModel) (*apiClientResourceModel, error) {
+	plan.Id = types.StringValue(d.Id)
+	plan.Alias = types.StringValue(d.Alias)
+
+	apiScopes := client.ApiKeyScopes{}
+	if d.Scopes != nil {
+		apiScopes = *d.Scopes
+	}
+
+	scopes, err := convertScopesFromApiModel(apiScopes)
+	if err != nil {
+		var r *apiClientResourceModel
+		return r, err
+	}
+	plan.Scopes = scopes
+
+	return plan, nil
+}
+
+func (r *apiClientResource) convertToApiModel(ctx context.Context, plan *apiClientResourceModel) (client.ApiClientRequest, error) {
+	req := client.ApiClientRequest{
+		Alias: plan.Alias.ValueString(),
+	}
+
+	converted, err := convertScopesToApiModel(ctx, plan.Scopes)
+	if err != nil {
+		var r client.ApiClientRequest
+		return r, err
+	}
+	req.Scopes = &converted
+
+	return req, nil
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-discue/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccApiClientResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "discue_api_client" "test_client" {
+  alias = "my-first-api-client"
+  scopes = [{
+	  resource = "subscriptions"
+	  access = "read"
+	  targets = ["*"]
+  }]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_api_client.test_client", "alias", "my-first-api-client"),
+					resource.TestCheckResourceAttrSet("discue_api_client.test_client", "id"),
+					resource.TestCheckResourceAttrSet("discue_api_client.test_client", "client_secret"),
+
+					testCheckScope("discue_api_client.test_client", "subscriptions", "read", "*"),
+					testCheckNoScope("discue_api_client.test_client", "api_clients"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "discue_api_client.test_client",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The secret is only returned once after creation.
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "discue_api_client" "test_client" {
+  alias = "my-first-api-client-now"
+  scopes = [{
+	  resource = "api_clients"
+	  access = "write"
+	  targets = ["*"]
+  }, {
+	  resource = "subscriptions"
+	  access = "write"
+	  targets = ["*"]
+  }]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_api_client.test_client", "alias", "my-first-api-client-now"),
+					resource.TestCheckResourceAttrSet("discue_api_client.test_client", "client_secret"),
+
+					testCheckScope("discue_api_client.test_client", "api_clients", "write", "*"),
+					testCheckScope("discue_api_client.test_client", "subscriptions", "write", "*"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccApiClientResource_identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_api_client" "test_client" {
+  alias = "my-identified-api-client"
+  scopes = [{
+	  resource = "subscriptions"
+	  access = "read"
+	  targets = ["*"]
+  }]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("discue_api_client.test_client", tfjsonpath.New("id")),
+				},
+			},
+			{
+				// test import with an import block using the identity of the resource
+				ResourceName:    "discue_api_client.test_client",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccApiClientResource_disappears(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Delete the object out-of-band and expect it to be planned for re-creation
+			{
+				Config: providerConfig + `
+resource "discue_api_client" "test_client" {
+  alias = "my-deleted-api-client"
+  scopes = [{
+	  resource = "queues"
+  }]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("discue_api_client.test_client", "id"),
+					testAccDeleteOutOfBand("discue_api_client.test_client", func(ctx context.Context, c *client.Client, rs *terraform.ResourceState) error {
+						_, err := c.DeleteApiClient(ctx, rs.Primary.ID)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
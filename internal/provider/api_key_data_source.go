@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-discue/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSource = &apiKeyDataSource{}
+var _ datasource.DataSourceWithConfigure = &apiKeyDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &apiKeyDataSource{}
+
+func NewApiKeyDataSource() datasource.DataSource {
+	return &apiKeyDataSource{}
+}
+
+type apiKeyDataSource struct {
+	client *client.Client
+}
+
+func (d *apiKeyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "api_key"}, "_")
+}
+
+func (d *apiKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single api key by id or alias. Only the prefix of the key is returned, the whole key is only available after creation.",
+		Attributes:  withLookupAttributes(apiKeyDataSourceAttributes()),
+	}
+}
+
+func (d *apiKeyDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return lookupConfigValidators()
+}
+
+func (d *apiKeyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *apiKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ApiKeyModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var k *client.ApiKeyResponse
+	var err error
+	if HasValue(state.Id) {
+		k, err = d.client.GetApiKey(ctx, state.Id.ValueString())
+	} else {
+		var apiKeys []client.ApiKeyResponse
+		apiKeys, err = d.client.ListApiKeys(ctx)
+		if err == nil {
+			k, err = findByAlias(apiKeys, state.Alias.ValueString(), func(k *client.ApiKeyResponse) string { return k.Alias })
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading api key via API",
+			"Could not read api key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = (&apiKeyResource{}).convertFromApiModel(k, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting api key received from API to internal model",
+			"Could not convert api key, unexpected error: "+err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApiKeyDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_api_key" "test_key" {
+  alias = "my-looked-up-api-key"
+  scopes = [{
+	  resource = "queues"
+	  access = "read"
+  }]
+}
+
+data "discue_api_key" "by_id" {
+  id = discue_api_key.test_key.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.discue_api_key.by_id", "alias", "my-looked-up-api-key"),
+					resource.TestCheckResourceAttr("data.discue_api_key.by_id", "status", "enabled"),
+					resource.TestCheckResourceAttr("data.discue_api_key.by_id", "scopes.0.resource", "queues"),
+					resource.TestCheckResourceAttr("data.discue_api_key.by_id", "scopes.0.access", "read"),
+				),
+			},
+		},
+	})
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -25,8 +27,9 @@ import (
 var _ resource.Resource = &apiKeyResource{}
 var _ resource.ResourceWithConfigure = &apiKeyResource{}
 var _ resource.ResourceWithImportState = &apiKeyResource{}
+var _ resource.ResourceWithIdentity = &apiKeyResource{}
 
-var ApiResources = []string{"channels", "domains", "events", "listeners", "messages", "queues", "schemas", "stats", "topics"}
+var ApiResources = []string{"api_clients", "channels", "domains", "events", "listeners", "messages", "queues", "schemas", "stats", "subscriptions", "topics"}
 
 func NewApiKeyResource() resource.Resource {
 	return &apiKeyResource{}
@@ -36,12 +39,18 @@ type apiKeyResource struct {
 	client *client.Client
 }
 
+// ApiKeyModel contains the attributes of an api key shared by the resource and the data sources.
+type ApiKeyModel struct {
+	KeyPrefix types.String `tfsdk:"key_prefix"`
+	Id        types.String `tfsdk:"id"`
+	Alias     types.String `tfsdk:"alias"`
+	Status    types.String `tfsdk:"status"`
+	Scopes    types.List   `tfsdk:"scopes"`
+}
+
 type apiKeyResourceModel struct {
-	Key    types.String `tfsdk:"key"`
-	Id     types.String `tfsdk:"id"`
-	Alias  types.String `tfsdk:"alias"`
-	Status types.String `tfsdk:"status"`
-	Scopes types.List   `tfsdk:"scopes"`
+	ApiKeyModel
+	Key types.String `tfsdk:"key"`
 }
 
 type apiKeyScopeModel struct {
@@ -84,52 +93,67 @@ func (r *apiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"key": schema.StringAttribute{
-				MarkdownDescription: "The string representation of the API key. Only once after creation will the API return the whole API key. Afterward only a prefix of a few characters gets returned. Marked as sensitive to prevent leakage. See also: [api-overview#authentication](https://docs.discue.io/api-overview/#authentication)",
+				MarkdownDescription: "The string representation of the API key. Only once after creation will the API return the whole API key. The provider keeps this value in state, later reads do not change it. The key is not available for imported api keys. Marked as sensitive to prevent leakage. See also: [api-overview#authentication](https://docs.discue.io/api-overview/#authentication)",
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"scopes": schema.ListNestedAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "Scopes describe which resources can be access and what kind of access (read/write) was granted. If `targets` array is empty, access to all resources of the defined domain will be granted. Otherwise - if targets is a list of resource IDs - only access to resources with the given ids will be allowed.",
-				Validators: []validator.List{listvalidator.All(
-					listvalidator.IsRequired(),
-					listvalidator.SizeAtLeast(1),
-				)},
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"resource": schema.StringAttribute{
-							Optional:    true,
-							Computed:    true,
-							Description: "The type of resources this API key will be allowed to access.",
-							Validators: []validator.String{
-								stringvalidator.OneOf(ApiResources...),
-							},
-						},
-						"access": schema.StringAttribute{
-							Optional:    true,
-							Computed:    true,
-							Description: "The access level that will be granted to the resource. Defaults to `write`.",
-							Default:     stringdefault.StaticString("write"),
-							Validators: []validator.String{
-								stringvalidator.OneOf("read", "write"),
-							},
-						},
-						"targets": schema.ListAttribute{
-							ElementType: types.StringType,
-							Optional:    true,
-							Computed:    true,
-							Description: "The target resources this API key will be allowed to access. Either a list of resource IDs or a wildcard. Defaults to `[\"*\"].`",
-							Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("*")})),
-							Validators: []validator.List{
-								listvalidator.ValueStringsAre(
-									stringvalidator.Any(
-										stringvalidator.OneOf("*"),
-										v.ValidResourceId(""),
-									),
-								),
-							},
-						},
+			"key_prefix": schema.StringAttribute{
+				MarkdownDescription: "The prefix of the API key as returned by the API after creation. If it does not match `key` anymore, the key was rotated outside of terraform.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scopes": scopesAttribute("API key"),
+		},
+	}
+}
+
+// scopesAttribute returns the schema of the scopes granted to an API key or an API client
+func scopesAttribute(owner string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Scopes describe which resources can be access and what kind of access (read/write) was granted. If `targets` array is empty, access to all resources of the defined domain will be granted. Otherwise - if targets is a list of resource IDs - only access to resources with the given ids will be allowed.",
+		Validators: []validator.List{listvalidator.All(
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+		)},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"resource": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "The type of resources this " + owner + " will be allowed to access.",
+					Validators: []validator.String{
+						stringvalidator.OneOf(ApiResources...),
+					},
+				},
+				"access": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "The access level that will be granted to the resource. Defaults to `write`.",
+					Default:     stringdefault.StaticString("write"),
+					Validators: []validator.String{
+						stringvalidator.OneOf("read", "write"),
+					},
+				},
+				"targets": schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Computed:    true,
+					Description: "The target resources this " + owner + " will be allowed to access. Either a list of resource IDs or a wildcard. Defaults to `[\"*\"].`",
+					Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("*")})),
+					Validators: []validator.List{
+						listvalidator.ValueStringsAre(
+							stringvalidator.Any(
+								stringvalidator.OneOf("*"),
+								v.ValidResourceId(""),
+							),
+						),
 					},
 				},
 			},
@@ -172,7 +196,7 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	k, err := r.client.CreateApiKey(payload)
+	k, err := r.client.CreateApiKey(ctx, payload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating api key via API",
@@ -181,7 +205,10 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	k, err = r.client.GetApiKey(k.Id)
+	// the whole key is only returned once
+	plan.Key = types.StringValue(k.Key)
+
+	k, err = r.client.GetApiKey(ctx, k.Id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading api key via API",
@@ -190,17 +217,20 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	_, err = r.convertFromApiModel(k, &plan)
+	_, err = r.convertFromApiModel(k, &plan.ApiKeyModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error converting api key received from API to internal model",
 			"Could not convert api key, unexpected error: "+err.Error())
 		return
 	}
-	tflog.Info(ctx, fmt.Sprintf("Done Reading api client %s", plan))
+	tflog.Info(ctx, "Done reading api key", map[string]any{"id": plan.Id.ValueString()})
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -212,7 +242,12 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	k, err := r.client.GetApiKey(state.Id.ValueString())
+	k, err := r.client.GetApiKey(ctx, state.Id.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Api key no longer exists, removing it from state", map[string]any{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading api key via API",
@@ -221,17 +256,22 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	_, err = r.convertFromApiModel(k, &state)
+	_, err = r.convertFromApiModel(k, &state.ApiKeyModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error converting api key received from API to internal model",
 			"Could not convert api key, unexpected error: "+err.Error())
 		return
 	}
-	tflog.Info(ctx, fmt.Sprintf("Done Reading api client %s", state))
+	tflog.Info(ctx, "Done reading api key", map[string]any{"id": state.Id.ValueString()})
+
+	resp.Diagnostics.Append(checkKeyRotation(&state)...)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -258,7 +298,7 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	k, err := r.client.UpdateApiKey(state.Id.ValueString(), payload)
+	k, err := r.client.UpdateApiKey(ctx, state.Id.ValueString(), payload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating api key via API",
@@ -267,7 +307,7 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	k, err = r.client.GetApiKey(k.Id)
+	k, err = r.client.GetApiKey(ctx, k.Id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading api key via API",
@@ -276,17 +316,22 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	_, err = r.convertFromApiModel(k, &state)
+	_, err = r.convertFromApiModel(k, &state.ApiKeyModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error converting api key received from API to internal model",
 			"Could not convert api key, unexpected error: "+err.Error())
 		return
 	}
-	tflog.Info(ctx, fmt.Sprintf("Done Reading api client %s", state))
+	tflog.Info(ctx, "Done reading api key", map[string]any{"id": state.Id.ValueString()})
+
+	resp.Diagnostics.Append(checkKeyRotation(&state)...)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -297,8 +342,8 @@ func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	_, err := r.client.DeleteApiKey(state.Id.ValueString())
-	if err != nil {
+	_, err := r.client.DeleteApiKey(ctx, state.Id.ValueString())
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error deleting api key via API",
 			"Could not delete api key, unexpected error: "+err.Error(),
@@ -310,6 +355,10 @@ func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	resp.Diagnostics.Append(diags...)
 }
 
+func (r *apiKeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	idIdentitySchema(ctx, req, resp)
+}
+
 func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
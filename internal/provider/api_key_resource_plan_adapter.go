@@ -5,22 +5,30 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"terraform-provider-discue/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
-func (r *apiKeyResource) convertFromApiModel(d *client.ApiKeyResponse, plan *apiKeyResourceModel) (*apiKeyResourceModel, error) {
+func (r *apiKeyResource) convertFromApiModel(d *client.ApiKeyResponse, plan *ApiKeyModel) (*ApiKeyModel, error) {
 	plan.Id = types.StringValue(d.Id)
 	plan.Alias = types.StringValue(d.Alias)
 	plan.Status = types.StringValue(d.Status)
-	plan.Key = types.StringValue(d.Key)
+	plan.KeyPrefix = types.StringValue(d.Key)
 
-	scopes, err := convertScopesFromApiModel(*d.Scopes)
+	apiScopes := client.ApiKeyScopes{}
+	if d.Scopes != nil {
+		apiScopes = *d.Scopes
+	}
+
+	scopes, err := convertScopesFromApiModel(apiScopes)
 	if err != nil {
-		var r *apiKeyResourceModel
+		var r *ApiKeyModel
 		return r, err
 	}
 	plan.Scopes = scopes
@@ -28,11 +36,29 @@ func (r *apiKeyResource) convertFromApiModel(d *client.ApiKeyResponse, plan *api
 	return plan, nil
 }
 
+// checkKeyRotation warns if the key kept in state does not start with the prefix returned
+// by the API, which means the key was rotated outside of terraform.
+func checkKeyRotation(state *apiKeyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !HasValue(state.Key) || !HasValue(state.KeyPrefix) {
+		return diags
+	}
+
+	if !strings.HasPrefix(state.Key.ValueString(), state.KeyPrefix.ValueString()) {
+		diags.AddAttributeWarning(
+			path.Root("key"),
+			"API key was rotated outside of terraform",
+			fmt.Sprintf("The key of api key %s stored in state does not match the key prefix returned by the API. The key was probably rotated outside of terraform and the value of the key attribute is outdated. Replace the resource to obtain a new key.", state.Id.ValueString()),
+		)
+	}
+	return diags
+}
+
 func convertScopesFromApiModel(scopes client.ApiKeyScopes) (basetypes.ListValue, error) {
 	elements := []attr.Value{}
 
 	for _, name := range ApiResources {
-		keyName := uppercaseFirstCharacter(name)
+		keyName := snakeToPascalCase(name)
 		value, _ := getValueOf[*client.ApiKeyScope](scopes, keyName)
 		if value == nil {
 			continue
@@ -87,7 +113,7 @@ func (r *apiKeyResource) convertToApiModel(ctx context.Context, plan *apiKeyReso
 		Status: plan.Status.ValueString(),
 	}
 
-	converted, err := convertScopesToApiModel(ctx, plan)
+	converted, err := convertScopesToApiModel(ctx, plan.Scopes)
 	if err != nil {
 		var r client.ApiKeyRequest
 		return r, err
@@ -97,8 +123,8 @@ func (r *apiKeyResource) convertToApiModel(ctx context.Context, plan *apiKeyReso
 	return req, nil
 }
 
-func convertScopesToApiModel(ctx context.Context, plan *apiKeyResourceModel) (client.ApiKeyScopes, error) {
-	elements, diags := ListTypeToPlainArray[apiKeyScopeModel](ctx, plan.Scopes)
+func convertScopesToApiModel(ctx context.Context, planScopes types.List) (client.ApiKeyScopes, error) {
+	elements, diags := ListTypeToPlainArray[apiKeyScopeModel](ctx, planScopes)
 	if diags.HasError() {
 		var r client.ApiKeyScopes
 		return r, DiagsToStructuredError("Unable to convert to state/plan to struct", diags)
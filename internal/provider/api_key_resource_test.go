@@ -1,12 +1,18 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"terraform-provider-discue/internal/client"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func testCheckScope(resourceType string, name string, access string, target string) resource.TestCheckFunc {
@@ -39,6 +45,23 @@ func testCheckNoScope(resourceName string, scopeName string) resource.TestCheckF
 	}
 }
 
+func testCheckKeyMatchesPrefix(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		key := rs.Primary.Attributes["key"]
+		prefix := rs.Primary.Attributes["key_prefix"]
+		if len(key) <= len(prefix) || !strings.HasPrefix(key, prefix) {
+			return fmt.Errorf("Expected key to be the whole key starting with prefix %s", prefix)
+		}
+
+		return nil
+	}
+}
+
 func TestAccApiKeyResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -59,6 +82,8 @@ resource "discue_api_key" "test_alias" {
 					resource.TestCheckResourceAttr("discue_api_key.test_alias", "alias", "my-first-api-key"),
 					resource.TestCheckResourceAttr("discue_api_key.test_alias", "status", "enabled"),
 					resource.TestCheckResourceAttrSet("discue_api_key.test_alias", "id"),
+					resource.TestCheckResourceAttrSet("discue_api_key.test_alias", "key_prefix"),
+					testCheckKeyMatchesPrefix("discue_api_key.test_alias"),
 
 					testCheckScope("discue_api_key.test_alias", "topics", "read", "*"),
 					testCheckNoScope("discue_api_key.test_alias", "channels"),
@@ -78,7 +103,8 @@ resource "discue_api_key" "test_alias" {
 				ImportStateVerify: true,
 				// The last_updated attribute does not exist in the HashiCups
 				// API, therefore there is no value for it during import.
-				ImportStateVerifyIgnore: []string{"last_updated"},
+				// The whole key is only returned once after creation.
+				ImportStateVerifyIgnore: []string{"last_updated", "key"},
 			},
 			// Update and Read testing
 			{
@@ -95,6 +121,7 @@ resource "discue_api_key" "test_alias" {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("discue_api_key.test_alias", "alias", "my-first-api-key-now"),
 					resource.TestCheckResourceAttrSet("discue_api_key.test_alias", "id"),
+					testCheckKeyMatchesPrefix("discue_api_key.test_alias"),
 					resource.TestCheckResourceAttr("discue_api_key.test_alias", "status", "enabled"),
 
 					testCheckScope("discue_api_key.test_alias", "topics", "read", "*"),
@@ -199,3 +226,64 @@ resource "discue_api_key" "test_scopes" {
 		},
 	})
 }
+
+func TestAccApiKeyResource_identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_api_key" "test_alias" {
+  alias = "my-identified-api-key"
+  scopes = [{
+    resource = "topics"
+    access = "read"
+    targets = ["*"]
+  }]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("discue_api_key.test_alias", tfjsonpath.New("id")),
+				},
+			},
+			{
+				// test import with an import block using the identity of the resource
+				ResourceName:    "discue_api_key.test_alias",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccApiKeyResource_disappears(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Delete the object out-of-band and expect it to be planned for re-creation
+			{
+				Config: providerConfig + `
+resource "discue_api_key" "test_alias" {
+  alias = "my-deleted-api-key"
+  scopes = [{
+	  resource = "topics"
+	  access = "read"
+	  targets = ["*"]
+  }]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("discue_api_key.test_alias", "id"),
+					testAccDeleteOutOfBand("discue_api_key.test_alias", func(ctx context.Context, c *client.Client, rs *terraform.ResourceState) error {
+						_, err := c.DeleteApiKey(ctx, rs.Primary.ID)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-discue/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &apiKeysDataSource{}
+var _ datasource.DataSourceWithConfigure = &apiKeysDataSource{}
+
+func NewApiKeysDataSource() datasource.DataSource {
+	return &apiKeysDataSource{}
+}
+
+type apiKeysDataSource struct {
+	client *client.Client
+}
+
+type apiKeysDataSourceModel struct {
+	aliasFilterModel
+	Status  types.String  `tfsdk:"status"`
+	Ids     types.List    `tfsdk:"ids"`
+	ApiKeys []ApiKeyModel `tfsdk:"api_keys"`
+}
+
+// apiKeyDataSourceAttributes returns the attributes of an api key as exposed by data sources.
+func apiKeyDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique id of the resource.",
+		},
+		"alias": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name/alias of the resource.",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "The status of the api key.",
+		},
+		"key_prefix": schema.StringAttribute{
+			Computed:    true,
+			Description: "The prefix of the API key.",
+		},
+		"scopes": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Scopes describe which resources can be access and what kind of access (read/write) was granted.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"resource": schema.StringAttribute{
+						Computed:    true,
+						Description: "The type of resources this API key is allowed to access.",
+					},
+					"access": schema.StringAttribute{
+						Computed:    true,
+						Description: "The access level granted to the resource.",
+					},
+					"targets": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+						Description: "The target resources this API key is allowed to access. Either a list of resource IDs or a wildcard.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *apiKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "api_keys"}, "_")
+}
+
+func (d *apiKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all api keys of the organization. Optional filters can be used to narrow down the result.",
+		Attributes: mergeAttributes(aliasFilterAttributes(), map[string]schema.Attribute{
+			"status": statusFilterAttribute(),
+			"ids":    idsAttribute(),
+			"api_keys": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "All api keys matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: apiKeyDataSourceAttributes(),
+				},
+			},
+		}),
+	}
+}
+
+func (d *apiKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *apiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state apiKeysDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matchesAlias, err := state.matcher()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid api key filter", err.Error())
+		return
+	}
+
+	apiKeys, err := d.client.ListApiKeys(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing api keys via API",
+			"Could not list api keys, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	converter := apiKeyResource{}
+	ids := []string{}
+	state.ApiKeys = []ApiKeyModel{}
+	for _, k := range apiKeys {
+		if !matchesAlias(k.Alias) || !matchesStatus(state.Status, k.Status) {
+			continue
+		}
+
+		var model ApiKeyModel
+		_, err = converter.convertFromApiModel(&k, &model)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error converting api key received from API to internal model",
+				"Could not convert api key, unexpected error: "+err.Error())
+			return
+		}
+
+		ids = append(ids, k.Id)
+		state.ApiKeys = append(state.ApiKeys, model)
+	}
+
+	state.Ids, diags = PlainStringArrayToListType(ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApiKeysDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_api_key" "enabled" {
+  alias = "listed-api-key-enabled"
+  scopes = [{
+	  resource = "queues"
+	  access = "read"
+  }]
+}
+
+resource "discue_api_key" "disabled" {
+  alias = "listed-api-key-disabled"
+  status = "disabled"
+  scopes = [{
+	  resource = "queues"
+	  access = "read"
+  }]
+}
+
+data "discue_api_keys" "disabled" {
+  alias_prefix = "listed-api-key-"
+  status = "disabled"
+
+  depends_on = [discue_api_key.enabled, discue_api_key.disabled]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.discue_api_keys.disabled", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.discue_api_keys.disabled", "ids.0", "discue_api_key.disabled", "id"),
+					resource.TestCheckResourceAttr("data.discue_api_keys.disabled", "api_keys.0.alias", "listed-api-key-disabled"),
+					resource.TestCheckResourceAttr("data.discue_api_keys.disabled", "api_keys.0.status", "disabled"),
+					resource.TestCheckResourceAttr("data.discue_api_keys.disabled", "api_keys.0.scopes.0.resource", "queues"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,315 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-discue/internal/client"
+	v "terraform-provider-discue/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &channelResource{}
+	_ resource.ResourceWithConfigure   = &channelResource{}
+	_ resource.ResourceWithImportState = &channelResource{}
+	_ resource.ResourceWithIdentity    = &channelResource{}
+)
+
+func NewChannelResource() resource.Resource {
+	return &channelResource{}
+}
+
+type channelResource struct {
+	client *client.Client
+}
+
+type ChannelResourceModel struct {
+	Alias    types.String `tfsdk:"alias"`
+	Id       types.String `tfsdk:"id"`
+	DomainId types.String `tfsdk:"domain_id"`
+	Path     types.String `tfsdk:"path"`
+}
+
+func (r *channelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "channel"}, "_")
+}
+
+func (r *channelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Channels deliver messages to clients. A channel with a `domain_id` and a `path` pushes messages to the given path of a domain, which must be verified before it can be referenced. A channel without both only allows clients to pull messages.",
+		Description:         "Channel resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique id of the resource.",
+				Validators: []validator.String{
+					v.ValidResourceId(""),
+				},
+			},
+			"alias": schema.StringAttribute{
+				Required:    true,
+				Description: "The name/alias of the resource. This should be unique.",
+				Validators: []validator.String{
+					v.ValidResourceAlias(""),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The id of the verified domain messages will be pushed to. Requires `path` to be set.",
+				Validators: []validator.String{
+					v.ValidResourceId(""),
+					stringvalidator.AlsoRequires(path.MatchRoot("path")),
+				},
+			},
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path messages will be pushed to. Must start with a slash. Requires `domain_id` to be set.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must start with a slash"),
+					stringvalidator.AlsoRequires(path.MatchRoot("domain_id")),
+				},
+			},
+		},
+	}
+}
+
+func (r *channelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *channelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ChannelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkDomainVerified(ctx, plan.DomainId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, err := r.convertToApiModel(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting channel to API model",
+			"Could not convert channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	c, err := r.client.CreateChannel(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating channel via API",
+			"Could not create channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	c, err = r.client.GetChannel(ctx, c.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading channel via API",
+			"Could not read channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.convertFromApiModel(c, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting channel received from API to internal model",
+			"Could not convert channel, unexpected error: "+err.Error())
+
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *channelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ChannelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var c, err = r.client.GetChannel(ctx, state.Id.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Channel no longer exists, removing it from state", map[string]any{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading channel via API",
+			"Could not read channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.convertFromApiModel(c, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting channel received from API to internal model",
+			"Could not convert channel, unexpected error: "+err.Error())
+
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *channelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ChannelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+
+	var state ChannelResourceModel
+	req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkDomainVerified(ctx, plan.DomainId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, err := r.convertToApiModel(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting channel to API model",
+			"Could not convert channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.client.UpdateChannel(ctx, state.Id.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating channel via API",
+			"Could not update channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	c, err := r.client.GetChannel(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading channel via API",
+			"Could not read channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.convertFromApiModel(c, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting channel received from API to internal model",
+			"Could not convert channel, unexpected error: "+err.Error())
+
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *channelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ChannelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var _, err = r.client.DeleteChannel(ctx, state.Id.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting channel via API",
+			"Could not delete channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// checkDomainVerified returns an error if the domain referenced by the channel
+// has not been verified yet, because the API only pushes messages to verified domains.
+func (r *channelResource) checkDomainVerified(ctx context.Context, domainId types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !HasValue(domainId) {
+		return diags
+	}
+
+	d, err := r.client.GetDomain(ctx, domainId.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("domain_id"),
+			"Error reading domain via API",
+			"Could not read domain of channel, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if d.Verification == nil || !d.Verification.Verified {
+		diags.AddAttributeError(
+			path.Root("domain_id"),
+			"Domain is not verified",
+			fmt.Sprintf("Domain %s (%s) must be verified before a channel can push messages to it.", d.Alias, d.Id),
+		)
+	}
+	return diags
+}
+
+func (r *channelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	idIdentitySchema(ctx, req, resp)
+}
+
+func (r *channelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-discue/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *channelResource) convertToApiModel(_ context.Context, plan *ChannelResourceModel) (client.Channel, error) {
+	return client.Channel{
+		Alias:    plan.Alias.ValueString(),
+		DomainId: OptionalString(plan.DomainId),
+		Path:     OptionalString(plan.Path),
+	}, nil
+}
+
+func (r *channelResource) convertFromApiModel(d *client.Channel, plan *ChannelResourceModel) error {
+	plan.Id = types.StringValue(d.Id)
+	plan.Alias = types.StringValue(d.Alias)
+	plan.DomainId = types.StringPointerValue(d.DomainId)
+	plan.Path = types.StringPointerValue(d.Path)
+
+	return nil
+}
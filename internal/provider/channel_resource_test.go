@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-discue/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccChannelResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// test error when domain_id is set without path
+				Config: providerConfig + `
+resource "discue_channel" "test_channel" {
+  alias     = "my-first-channel"
+  domain_id = "8ZZLCLpYwRtHV3jZUAGkq"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				// test error when path does not start with a slash
+				Config: providerConfig + `
+resource "discue_channel" "test_channel" {
+  alias     = "my-first-channel"
+  domain_id = "8ZZLCLpYwRtHV3jZUAGkq"
+  path      = "messages"
+}
+`,
+				ExpectError: regexp.MustCompile("must start with a slash"),
+			},
+			{
+				// test error when domain is not verified
+				Config: providerConfig + `
+resource "discue_domain" "test_domain" {
+  alias    = "my-unverified-domain"
+  hostname = "discue.io"
+  port     = 443
+}
+
+resource "discue_channel" "test_channel" {
+  alias     = "my-first-channel"
+  domain_id = discue_domain.test_domain.id
+  path      = "/messages"
+}
+`,
+				ExpectError: regexp.MustCompile("Domain is not verified"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "discue_channel" "test_channel" {
+  alias = "my-first-channel"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_channel.test_channel", "alias", "my-first-channel"),
+					resource.TestCheckNoResourceAttr("discue_channel.test_channel", "domain_id"),
+					resource.TestCheckNoResourceAttr("discue_channel.test_channel", "path"),
+					resource.TestCheckResourceAttrSet("discue_channel.test_channel", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "discue_channel.test_channel",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "discue_channel" "test_channel" {
+  alias = "my-first-channel-with-new-alias"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_channel.test_channel", "alias", "my-first-channel-with-new-alias"),
+					resource.TestCheckResourceAttrSet("discue_channel.test_channel", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccChannelResource_verifiedDomain(t *testing.T) {
+	hostname, port := testAccChallengeServer(t, "challenge-content")
+
+	channelConfig := func(path string) string {
+		return testAccVerifyDomainActionConfig(hostname, port) + fmt.Sprintf(`
+resource "discue_channel" "test_channel" {
+  alias     = "my-pushing-channel"
+  domain_id = discue_domain.test_domain.id
+  path      = %q
+
+  # the domain has to be verified before the channel can push to it
+  depends_on = [terraform_data.verify_trigger]
+}
+`, path)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: channelConfig("/messages"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("discue_channel.test_channel", "domain_id", "discue_domain.test_domain", "id"),
+					resource.TestCheckResourceAttr("discue_channel.test_channel", "path", "/messages"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "discue_channel.test_channel",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: channelConfig("/events"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("discue_channel.test_channel", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_channel.test_channel", "path", "/events"),
+				),
+			},
+			// Change the path out-of-band and expect the drift to be planned for correction
+			{
+				Config: channelConfig("/events"),
+				Check: func(s *terraform.State) error {
+					rs := s.RootModule().Resources["discue_channel.test_channel"]
+					c, err := testAccClient()
+					if err != nil {
+						return err
+					}
+					domainId := rs.Primary.Attributes["domain_id"]
+					path := "/drifted"
+					_, err = c.UpdateChannel(context.Background(), rs.Primary.ID, client.Channel{Alias: rs.Primary.Attributes["alias"], DomainId: &domainId, Path: &path})
+					return err
+				},
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: channelConfig("/events"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("discue_channel.test_channel", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_channel.test_channel", "path", "/events"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChannelResource_identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_channel" "test_channel" {
+  alias = "my-identified-channel"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("discue_channel.test_channel", tfjsonpath.New("id")),
+				},
+			},
+			{
+				// test import with an import block using the identity of the resource
+				ResourceName:    "discue_channel.test_channel",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccChannelResource_disappears(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Delete the object out-of-band and expect it to be planned for re-creation
+			{
+				Config: providerConfig + `
+resource "discue_channel" "test_channel" {
+  alias = "my-deleted-channel"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("discue_channel.test_channel", "id"),
+					testAccDeleteOutOfBand("discue_channel.test_channel", func(ctx context.Context, c *client.Client, rs *terraform.ResourceState) error {
+						_, err := c.DeleteChannel(ctx, rs.Primary.ID)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
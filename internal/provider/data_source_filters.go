@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	v "terraform-provider-discue/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// aliasFilterModel holds the alias filters shared by all plural data sources.
+type aliasFilterModel struct {
+	Alias       types.String `tfsdk:"alias"`
+	AliasPrefix types.String `tfsdk:"alias_prefix"`
+	AliasRegex  types.String `tfsdk:"alias_regex"`
+}
+
+func aliasFilterAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"alias": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return resources with exactly this alias.",
+			Validators: []validator.String{
+				v.ValidResourceAlias(""),
+			},
+		},
+		"alias_prefix": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return resources whose alias starts with this prefix.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"alias_regex": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return resources whose alias matches this regular expression.",
+			Validators: []validator.String{
+				v.ValidRegex(""),
+			},
+		},
+	}
+}
+
+// matcher returns a function that checks whether an alias passes all configured filters.
+func (f aliasFilterModel) matcher() (func(alias string) bool, error) {
+	var aliasRegex *regexp.Regexp
+	if HasValue(f.AliasRegex) {
+		var err error
+		aliasRegex, err = regexp.Compile(f.AliasRegex.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid alias_regex: %w", err)
+		}
+	}
+
+	return func(alias string) bool {
+		if HasValue(f.Alias) && alias != f.Alias.ValueString() {
+			return false
+		}
+		if HasValue(f.AliasPrefix) && !strings.HasPrefix(alias, f.AliasPrefix.ValueString()) {
+			return false
+		}
+		if aliasRegex != nil && !aliasRegex.MatchString(alias) {
+			return false
+		}
+		return true
+	}, nil
+}
+
+func statusFilterAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "Only return resources with this status.",
+		Validators: []validator.String{
+			stringvalidator.OneOf("enabled", "disabled"),
+		},
+	}
+}
+
+// matchesStatus checks whether a status passes the configured status filter.
+func matchesStatus(filter types.String, status string) bool {
+	return !HasValue(filter) || filter.ValueString() == status
+}
+
+func idsAttribute() schema.Attribute {
+	return schema.ListAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "The ids of all resources matching the filters.",
+	}
+}
+
+func mergeAttributes(attributeMaps ...map[string]schema.Attribute) map[string]schema.Attribute {
+	result := map[string]schema.Attribute{}
+	for _, attributes := range attributeMaps {
+		for name, attribute := range attributes {
+			result[name] = attribute
+		}
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	v "terraform-provider-discue/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// withLookupAttributes makes id and alias of a singular data source configurable, so that
+// objects can be looked up by either of them.
+func withLookupAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The unique id of the resource. Either `id` or `alias` must be set.",
+		Validators: []validator.String{
+			v.ValidResourceId(""),
+		},
+	}
+	attributes["alias"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The name/alias of the resource. Either `id` or `alias` must be set.",
+		Validators: []validator.String{
+			v.ValidResourceAlias(""),
+		},
+	}
+	return attributes
+}
+
+func lookupConfigValidators() []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("alias"),
+		),
+	}
+}
+
+// findByAlias returns the only element with the given alias. Aliases should be unique,
+// but this is not enforced by the API, so an ambiguous alias is reported as an error.
+func findByAlias[T any](elements []T, alias string, aliasOf func(*T) string) (*T, error) {
+	var found *T
+	for i := range elements {
+		if aliasOf(&elements[i]) != alias {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("found more than one resource with alias %s", alias)
+		}
+		found = &elements[i]
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("found no resource with alias %s", alias)
+	}
+	return found, nil
+}
@@ -0,0 +1,449 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"terraform-provider-discue/internal/client"
+	v "terraform-provider-discue/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &domainChallengeFileResource{}
+var _ resource.ResourceWithConfigure = &domainChallengeFileResource{}
+var _ resource.ResourceWithImportState = &domainChallengeFileResource{}
+var _ resource.ResourceWithModifyPlan = &domainChallengeFileResource{}
+var _ resource.ResourceWithIdentity = &domainChallengeFileResource{}
+
+const (
+	challengeFilePermission      os.FileMode = 0644
+	challengeDirectoryPermission os.FileMode = 0755
+)
+
+func NewDomainChallengeFileResource() resource.Resource {
+	return &domainChallengeFileResource{}
+}
+
+type domainChallengeFileResource struct {
+	client *client.Client
+}
+
+type domainChallengeFileResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	DomainId    types.String `tfsdk:"domain_id"`
+	WebRoot     types.String `tfsdk:"web_root"`
+	Path        types.String `tfsdk:"path"`
+	FileContent types.String `tfsdk:"file_content"`
+}
+
+// domainChallengeFileIdentityModel identifies a challenge file, the same domain can be served from several web roots.
+type domainChallengeFileIdentityModel struct {
+	DomainId types.String `tfsdk:"domain_id"`
+	WebRoot  types.String `tfsdk:"web_root"`
+}
+
+func (r *domainChallengeFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "domain_challenge_file"}, "_")
+}
+
+func (r *domainChallengeFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Writes the https challenge file of a `discue_domain` to the web root of a local web server, so that the domain can be verified. The file is written to `{web_root}{context_path}/{file_name}` with permissions `0644`, missing directories are created with permissions `0755`. The file is rewritten if it was changed outside of terraform or if the API rotated the challenge, and it is recreated if it was removed. Deleting the resource removes the file, but not the directories.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the resource in the format `<domain_id>,<web_root>`. Also used to import the resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The id of the domain to write the challenge file for. Changing the domain forces a new file to be written.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					v.ValidResourceId(""),
+				},
+			},
+			"web_root": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The directory the web server of the domain serves files from. Changing the web root forces a new file to be written.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"path": schema.StringAttribute{
+				Computed:    true,
+				Description: "The path of the challenge file.",
+			},
+			"file_content": schema.StringAttribute{
+				Computed:    true,
+				Description: "The content of the challenge file.",
+			},
+		},
+	}
+}
+
+func (r *domainChallengeFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan compares the challenge currently returned by the API with the file in state,
+// so that a rotated challenge or a file changed outside of terraform lead to an update.
+func (r *domainChallengeFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// the provider is not configured yet, e.g. while validating or if its configuration is unknown
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan domainChallengeFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DomainId.IsUnknown() || plan.WebRoot.IsUnknown() {
+		return
+	}
+
+	d, err := r.client.GetDomain(ctx, plan.DomainId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("domain_id"),
+			"Error reading domain via API",
+			"Could not read domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.convertFromApiModel(d, &plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("domain_id"),
+			"Error converting domain challenge to challenge file",
+			"Could not determine challenge file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *domainChallengeFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan domainChallengeFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d, err := r.client.GetDomain(ctx, plan.DomainId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain via API",
+			"Could not read domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.convertFromApiModel(d, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting domain challenge to challenge file",
+			"Could not determine challenge file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = writeChallengeFile(plan.Path.ValueString(), plan.FileContent.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error writing challenge file",
+			"Could not write challenge file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, domainChallengeFileIdentityModel{DomainId: plan.DomainId, WebRoot: plan.WebRoot})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *domainChallengeFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state domainChallengeFileResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d, err := r.client.GetDomain(ctx, state.DomainId.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Domain of challenge file no longer exists, removing it from state", map[string]any{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain via API",
+			"Could not read domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// imported resources do not know their path yet
+	if state.Path.IsNull() {
+		_, err = r.convertFromApiModel(d, &state)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error converting domain challenge to challenge file",
+				"Could not determine challenge file, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	content, err := os.ReadFile(state.Path.ValueString())
+	if errors.Is(err, fs.ErrNotExist) {
+		tflog.Warn(ctx, "Challenge file no longer exists, removing it from state", map[string]any{"path": state.Path.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading challenge file",
+			"Could not read challenge file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	state.FileContent = types.StringValue(string(content))
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, domainChallengeFileIdentityModel{DomainId: state.DomainId, WebRoot: state.WebRoot})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *domainChallengeFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan domainChallengeFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state domainChallengeFileResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d, err := r.client.GetDomain(ctx, plan.DomainId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain via API",
+			"Could not read domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.convertFromApiModel(d, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting domain challenge to challenge file",
+			"Could not determine challenge file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// the challenge was rotated and the new file has a different path
+	if state.Path.ValueString() != plan.Path.ValueString() {
+		err = removeChallengeFile(state.Path.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error removing challenge file",
+				"Could not remove previous challenge file, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	err = writeChallengeFile(plan.Path.ValueString(), plan.FileContent.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error writing challenge file",
+			"Could not write challenge file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, domainChallengeFileIdentityModel{DomainId: plan.DomainId, WebRoot: plan.WebRoot})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *domainChallengeFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state domainChallengeFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := removeChallengeFile(state.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error removing challenge file",
+			"Could not remove challenge file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *domainChallengeFileResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"domain_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The id of the domain the challenge file was written for.",
+			},
+			"web_root": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The directory the challenge file was written to.",
+			},
+		},
+	}
+}
+
+func (r *domainChallengeFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity domainChallengeFileIdentityModel
+
+	if req.ID != "" {
+		// Split the import ID into domain_id and web_root, the web root may contain commas itself
+		parts := strings.SplitN(req.ID, ",", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			resp.Diagnostics.AddError("Unexpected Format of Import ID", fmt.Sprintf("Expected format: <domain_id>,<web_root> and got %s", req.ID))
+			return
+		}
+		identity.DomainId = types.StringValue(parts[0])
+		identity.WebRoot = types.StringValue(parts[1])
+	} else {
+		// imported with an identity instead of an import ID
+		diags := req.Identity.Get(ctx, &identity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state := domainChallengeFileResourceModel{}
+	state.Id = types.StringValue(strings.Join([]string{identity.DomainId.ValueString(), identity.WebRoot.ValueString()}, ","))
+	state.DomainId = identity.DomainId
+	state.WebRoot = identity.WebRoot
+	state.Path = types.StringNull()
+	state.FileContent = types.StringNull()
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// challengeFilePath returns the path of the challenge file below the web root. It returns
+// an error if the challenge would place the file outside of the web root.
+func challengeFilePath(webRoot string, challenge client.HttpsDomainChallenge) (string, error) {
+	if challenge.FileName == "" {
+		return "", errors.New("the domain challenge has no file name")
+	}
+
+	root := filepath.Clean(webRoot)
+	target := filepath.Join(root, filepath.FromSlash(challenge.ContextPath), filepath.FromSlash(challenge.FileName))
+
+	rel, err := filepath.Rel(root, target)
+	if err != nil {
+		return "", err
+	}
+	if rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("the challenge file %s/%s is not located inside of the web root", challenge.ContextPath, challenge.FileName)
+	}
+
+	return target, nil
+}
+
+// writeChallengeFile replaces the file atomically, so that the web server never serves a partial challenge.
+func writeChallengeFile(name string, content string) error {
+	dir := filepath.Dir(name)
+	err := os.MkdirAll(dir, challengeDirectoryPermission)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, "."+filepath.Base(name)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.WriteString(content)
+	if err == nil {
+		err = f.Chmod(challengeFilePermission)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), name)
+}
+
+func removeChallengeFile(name string) error {
+	err := os.Remove(name)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"strings"
+	"terraform-provider-discue/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *domainChallengeFileResource) convertFromApiModel(d *client.DomainResponse, plan *domainChallengeFileResourceModel) (*domainChallengeFileResourceModel, error) {
+	if d.Challenge == nil || d.Challenge.Https == nil {
+		var r *domainChallengeFileResourceModel
+		return r, errors.New("the API did not return an https challenge for domain " + d.Id + ", challenge files can only be used with challenge type https")
+	}
+
+	filePath, err := challengeFilePath(plan.WebRoot.ValueString(), *d.Challenge.Https)
+	if err != nil {
+		var r *domainChallengeFileResourceModel
+		return r, err
+	}
+
+	plan.Id = types.StringValue(strings.Join([]string{d.Id, plan.WebRoot.ValueString()}, ","))
+	plan.Path = types.StringValue(filePath)
+	plan.FileContent = types.StringValue(d.Challenge.Https.FileContent)
+
+	return plan, nil
+}
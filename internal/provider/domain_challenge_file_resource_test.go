@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"terraform-provider-discue/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccDomainChallengeFileResource(t *testing.T) {
+	webRoot := t.TempDir()
+	challengeFile := filepath.Join(webRoot, ".well-known", "acme-challenge", "abcd", "challenge-file.txt")
+	rotatedFile := filepath.Join(webRoot, ".well-known", "acme-challenge", "efgh", "rotated-file.txt")
+
+	var domainId string
+	config := providerConfig + fmt.Sprintf(`
+resource "discue_domain" "test_domain" {
+  alias = "my-challenged-domain"
+  hostname = "discue.io"
+  port = 443
+}
+
+resource "discue_domain_challenge_file" "test_file" {
+  domain_id = discue_domain.test_domain.id
+  web_root = %q
+}
+`, webRoot)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_domain_challenge_file.test_file", "path", challengeFile),
+					resource.TestCheckResourceAttr("discue_domain_challenge_file.test_file", "file_content", "challenge-content"),
+					resource.TestCheckResourceAttrPair("discue_domain_challenge_file.test_file", "domain_id", "discue_domain.test_domain", "id"),
+					testCheckChallengeFile(challengeFile, "challenge-content"),
+					func(s *terraform.State) error {
+						domainId = s.RootModule().Resources["discue_domain.test_domain"].Primary.ID
+						return nil
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "discue_domain_challenge_file.test_file",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Drift detection when the file was changed outside of terraform
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(challengeFile, []byte("changed"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("discue_domain_challenge_file.test_file", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testCheckChallengeFile(challengeFile, "challenge-content"),
+			},
+			// Drift detection when the file was removed outside of terraform
+			{
+				PreConfig: func() {
+					if err := os.Remove(challengeFile); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("discue_domain_challenge_file.test_file", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: testCheckChallengeFile(challengeFile, "challenge-content"),
+			},
+			// The file is moved when the challenge rotates
+			{
+				PreConfig: func() {
+					testAccRotateDomainChallenge(t, domainId, client.HttpsDomainChallenge{
+						FileContent: "rotated-content",
+						FileName:    "rotated-file.txt",
+						ContextPath: "/.well-known/acme-challenge/efgh",
+					})
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("discue_domain_challenge_file.test_file", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_domain_challenge_file.test_file", "path", rotatedFile),
+					resource.TestCheckResourceAttr("discue_domain_challenge_file.test_file", "file_content", "rotated-content"),
+					testCheckChallengeFile(rotatedFile, "rotated-content"),
+					testCheckNoFile(challengeFile),
+				),
+			},
+			// Delete testing
+			{
+				Config: providerConfig,
+				Check:  testCheckNoFile(rotatedFile),
+			},
+		},
+	})
+}
+
+func TestAccDomainChallengeFileResource_identity(t *testing.T) {
+	webRoot := t.TempDir()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "discue_domain" "test_domain" {
+  alias = "my-identified-challenged-domain"
+  hostname = "discue.io"
+  port = 443
+}
+
+resource "discue_domain_challenge_file" "test_file" {
+  domain_id = discue_domain.test_domain.id
+  web_root = %q
+}
+`, webRoot),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("discue_domain_challenge_file.test_file", tfjsonpath.New("domain_id")),
+					statecheck.ExpectIdentityValueMatchesState("discue_domain_challenge_file.test_file", tfjsonpath.New("web_root")),
+				},
+			},
+			{
+				// test import with an import block using the identity of the resource
+				ResourceName:    "discue_domain_challenge_file.test_file",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestChallengeFilePath(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		challenge   client.HttpsDomainChallenge
+		expected    string
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"below context path": {
+			challenge: client.HttpsDomainChallenge{ContextPath: "/.well-known/acme-challenge", FileName: "file.txt"},
+			expected:  filepath.Join("/var/www", ".well-known", "acme-challenge", "file.txt"),
+		},
+		"without context path": {
+			challenge: client.HttpsDomainChallenge{FileName: "file.txt"},
+			expected:  filepath.Join("/var/www", "file.txt"),
+		},
+		"without file name": {
+			challenge:   client.HttpsDomainChallenge{ContextPath: "/.well-known"},
+			expectError: true,
+		},
+		"outside of web root": {
+			challenge:   client.HttpsDomainChallenge{ContextPath: "/../../etc", FileName: "passwd"},
+			expectError: true,
+		},
+		"file name outside of web root": {
+			challenge:   client.HttpsDomainChallenge{ContextPath: "/.well-known", FileName: "../../../file.txt"},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := challengeFilePath("/var/www", test.challenge)
+			if test.expectError {
+				if err == nil {
+					t.Fatalf("expected an error, got path %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+			if got != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func testCheckChallengeFile(name, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		info, err := os.Stat(name)
+		if err != nil {
+			return err
+		}
+		if info.Mode().Perm() != 0644 {
+			return fmt.Errorf("expected permissions 0644 of %s, got %s", name, info.Mode().Perm())
+		}
+		actual, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		if string(actual) != content {
+			return fmt.Errorf("expected content %q of %s, got %q", content, name, actual)
+		}
+		return nil
+	}
+}
+
+func testCheckNoFile(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, err := os.Stat(name); !os.IsNotExist(err) {
+			return fmt.Errorf("expected %s to be removed", name)
+		}
+		return nil
+	}
+}
+
+// testAccRotateDomainChallenge replaces the challenge of a domain, which only the test server allows.
+func testAccRotateDomainChallenge(t *testing.T, domainId string, challenge client.HttpsDomainChallenge) {
+	body, err := json.Marshal(map[string]any{"challenge": client.DomainChallenge{Https: &challenge}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodPut, testAccApiEndpoint()+"/domains/"+domainId, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("could not rotate domain challenge, got status %d", res.StatusCode)
+	}
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-discue/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSource = &domainDataSource{}
+var _ datasource.DataSourceWithConfigure = &domainDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &domainDataSource{}
+
+func NewDomainDataSource() datasource.DataSource {
+	return &domainDataSource{}
+}
+
+type domainDataSource struct {
+	client *client.Client
+}
+
+func (d *domainDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "domain"}, "_")
+}
+
+func (d *domainDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single domain by id or alias, e.g. to check whether a domain managed by another workspace has been verified.",
+		Attributes:  withLookupAttributes(domainDataSourceAttributes()),
+	}
+}
+
+func (d *domainDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return lookupConfigValidators()
+}
+
+func (d *domainDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *domainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DomainModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var domain *client.DomainResponse
+	var err error
+	if HasValue(state.Id) {
+		domain, err = d.client.GetDomain(ctx, state.Id.ValueString())
+	} else {
+		var domains []client.DomainResponse
+		domains, err = d.client.ListDomains(ctx)
+		if err == nil {
+			domain, err = findByAlias(domains, state.Alias.ValueString(), func(domain *client.DomainResponse) string { return domain.Alias })
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain via API",
+			"Could not read domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = (&domainResource{}).convertFromApiModel(domain, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting domain received from API to internal model",
+			"Could not convert domain, unexpected error: "+err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
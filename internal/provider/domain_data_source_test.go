@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDomainDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_domain" "test_domain" {
+  alias = "my-looked-up-domain"
+  hostname = "discue.io"
+  port = 443
+}
+
+data "discue_domain" "by_alias" {
+  alias = discue_domain.test_domain.alias
+
+  depends_on = [discue_domain.test_domain]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.discue_domain.by_alias", "id", "discue_domain.test_domain", "id"),
+					resource.TestCheckResourceAttr("data.discue_domain.by_alias", "hostname", "discue.io"),
+					resource.TestCheckResourceAttr("data.discue_domain.by_alias", "port", "443"),
+					resource.TestCheckResourceAttrPair("data.discue_domain.by_alias", "verification.verified", "discue_domain.test_domain", "verification.verified"),
+				),
+			},
+		},
+	})
+}
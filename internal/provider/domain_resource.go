@@ -10,20 +10,32 @@ import (
 	"strings"
 	"terraform-provider-discue/internal/client"
 	v "terraform-provider-discue/internal/validators"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &domainResource{}
 var _ resource.ResourceWithConfigure = &domainResource{}
 var _ resource.ResourceWithImportState = &domainResource{}
+var _ resource.ResourceWithIdentity = &domainResource{}
+
+const defaultDomainVerificationTimeout = 20 * time.Minute
 
 func NewDomainResource() resource.Resource {
 	return &domainResource{}
@@ -33,17 +45,26 @@ type domainResource struct {
 	client *client.Client
 }
 
+// DomainModel contains the attributes of a domain shared by the resource and the data sources.
+type DomainModel struct {
+	Alias         types.String          `tfsdk:"alias"`
+	Id            types.String          `tfsdk:"id"`
+	Hostname      types.String          `tfsdk:"hostname"`
+	Port          types.Int32           `tfsdk:"port"`
+	ChallengeType types.String          `tfsdk:"challenge_type"`
+	Challenge     basetypes.ObjectValue `tfsdk:"challenge"`
+	Verification  basetypes.ObjectValue `tfsdk:"verification"`
+}
+
 type DomainResourceModel struct {
-	Alias        types.String          `tfsdk:"alias"`
-	Id           types.String          `tfsdk:"id"`
-	Hostname     types.String          `tfsdk:"hostname"`
-	Port         types.Int32           `tfsdk:"port"`
-	Challenge    basetypes.ObjectValue `tfsdk:"challenge"`
-	Verification basetypes.ObjectValue `tfsdk:"verification"`
+	DomainModel
+	WaitForVerification types.Bool     `tfsdk:"wait_for_verification"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 type DomainChallenge struct {
-	Https HttpDomainChallenge `tfsdk:"https"`
+	Https *HttpDomainChallenge `tfsdk:"https"`
+	Dns   *DnsDomainChallenge  `tfsdk:"dns"`
 }
 
 type HttpDomainChallenge struct {
@@ -54,6 +75,14 @@ type HttpDomainChallenge struct {
 	ExpiresAt   types.Int64  `tfsdk:"expires_at"`
 }
 
+type DnsDomainChallenge struct {
+	RecordName  types.String `tfsdk:"record_name"`
+	RecordType  types.String `tfsdk:"record_type"`
+	RecordValue types.String `tfsdk:"record_value"`
+	CreatedAt   types.Int64  `tfsdk:"created_at"`
+	ExpiresAt   types.Int64  `tfsdk:"expires_at"`
+}
+
 type DomainVerification struct {
 	Verified   types.Bool  `tfsdk:"verified"`
 	VerifiedAt types.Int64 `tfsdk:"verified_at"`
@@ -65,7 +94,7 @@ func (r *domainResource) Metadata(ctx context.Context, req resource.MetadataRequ
 
 func (r *domainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "A domain resource is a prerequisite for receiving messages. This is a security measure to prevent messages being sent through [discue.io](https://www.discue.io) without knowledge of the recipient. The domain configuration includes a hostname and port. Both values cannot be changed after creation. The API will return instructions on how to validate the domain as a response to the creation request.",
+		MarkdownDescription: "A domain resource is a prerequisite for receiving messages. This is a security measure to prevent messages being sent through [discue.io](https://www.discue.io) without knowledge of the recipient. The domain configuration includes a hostname and port. Both values cannot be changed after creation, changing either of them will replace the domain. Combine the domain with `lifecycle { create_before_destroy = true }` to create the new domain before the old one gets deleted, so that listeners depending on it keep working during the apply. The API will return instructions on how to validate the domain as a response to the creation request.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -83,7 +112,10 @@ func (r *domainResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"hostname": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The target hostname that will receive messages from listeners and channels. Only provide the DNS hostname portion here. The protocol will be added by the API automatically.",
+				MarkdownDescription: "The target hostname that will receive messages from listeners and channels. Only provide the DNS hostname portion here. The protocol will be added by the API automatically. Changing the hostname forces a new domain to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(4, 253),
 					stringvalidator.RegexMatches(
@@ -94,7 +126,10 @@ func (r *domainResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"port": schema.Int32Attribute{
 				Required:            true,
-				MarkdownDescription: "The target port the messages will be sent to.",
+				MarkdownDescription: "The target port the messages will be sent to. Changing the port forces a new domain to be created.",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
 				Validators: []validator.Int32{
 					int32validator.Any(
 						int32validator.OneOf(80, 443),
@@ -102,6 +137,19 @@ func (r *domainResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					),
 				},
 			},
+			"challenge_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The challenge used to verify the domain. Either `https` to serve a file from the domain or `dns` to publish a TXT record, e.g. for domains behind a private ingress that cannot serve files publicly. Changing the challenge type issues a new challenge. Defaults to `https`.",
+				Default:             stringdefault.StaticString(client.DomainChallengeTypeHttps),
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.DomainChallengeTypeHttps, client.DomainChallengeTypeDns),
+				},
+			},
+			"wait_for_verification": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "If true, creating and updating the domain waits until the domain was verified. Waiting ends with an error if the domain challenge expires first or if the `create` or `update` timeout is exceeded. Defaults to false.",
+			},
 			"verification": schema.SingleNestedAttribute{
 				Computed:    true,
 				Description: "Describes the status of the domain validation. ",
@@ -118,11 +166,11 @@ func (r *domainResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"challenge": schema.SingleNestedAttribute{
 				Computed:    true,
-				Description: "A Domain challenge enables a domain to receive messages. This is a security measure to prevent other domains receiving unwanted messages. The API will send a HTTP Get request to https://{hostname}:{port}/{context_path}/{file_name} and will expect the respones to strictly equal {file_content}. If the content matches, the domain will me marked as verified.",
+				Description: "A Domain challenge enables a domain to receive messages. This is a security measure to prevent other domains receiving unwanted messages. Depending on the challenge type, either `https` or `dns` is set. For the https challenge the API will send a HTTP Get request to https://{hostname}:{port}/{context_path}/{file_name} and will expect the respones to strictly equal {file_content}. For the dns challenge the API will look up the TXT record {record_name} and will expect it to contain {record_value}. If the challenge is passed, the domain will me marked as verified.",
 				Attributes: map[string]schema.Attribute{
 					"https": schema.SingleNestedAttribute{
 						Computed:    true,
-						Description: "The HTTP challenge is currently the only way to verify a domain. This object contains all the relevant information for the client to pass the HTTP challenge.",
+						Description: "This object contains all the relevant information for the client to pass the HTTP challenge. Only set if the challenge type is https.",
 						Attributes: map[string]schema.Attribute{
 							"file_content": schema.StringAttribute{
 								Computed:    true,
@@ -146,9 +194,43 @@ func (r *domainResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							},
 						},
 					},
+					"dns": schema.SingleNestedAttribute{
+						Computed:    true,
+						Description: "This object contains all the relevant information for the client to pass the DNS challenge. Only set if the challenge type is dns.",
+						Attributes: map[string]schema.Attribute{
+							"record_name": schema.StringAttribute{
+								Computed:    true,
+								Description: "The fully qualified name of the DNS record we will look up.",
+							},
+							"record_type": schema.StringAttribute{
+								Computed:    true,
+								Description: "The type of the DNS record, e.g. TXT.",
+							},
+							"record_value": schema.StringAttribute{
+								Computed:    true,
+								Description: "The value we expect the DNS record to contain.",
+							},
+							"created_at": schema.Int64Attribute{
+								Computed:    true,
+								Description: "A timestamp representing the date time the domain challenge was created.",
+							},
+							"expires_at": schema.Int64Attribute{
+								Computed:    true,
+								Description: "A timestamp representing the date time the domain challenge will expire.",
+							},
+						},
+					},
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				Update:            true,
+				CreateDescription: "How long to wait for the domain to be verified after creation if `wait_for_verification` is true. Defaults to 20m.",
+				UpdateDescription: "How long to wait for the domain to be verified during an update if `wait_for_verification` is true. Defaults to 20m.",
+			}),
+		},
 	}
 }
 
@@ -196,7 +278,7 @@ func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	d, err := r.client.CreateDomain(payload)
+	d, err := r.client.CreateDomain(ctx, payload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating domain via API",
@@ -205,7 +287,7 @@ func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	d, err = r.client.GetDomain(d.Id)
+	d, err = r.client.GetDomain(ctx, d.Id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading domain via API",
@@ -214,7 +296,7 @@ func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	_, err = r.convertFromApiModel(d, &plan)
+	_, err = r.convertFromApiModel(d, &plan.DomainModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error converting domain received from API to internal model",
@@ -225,6 +307,22 @@ func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultDomainVerificationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the domain already exists and was saved to the state, an error while
+	// waiting will therefore mark it as tainted
+	r.waitForVerification(ctx, &plan, createTimeout, &resp.State, &resp.Diagnostics)
 }
 
 func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -235,7 +333,12 @@ func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	var d, err = r.client.GetDomain(state.Id.ValueString())
+	var d, err = r.client.GetDomain(ctx, state.Id.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Domain no longer exists, removing it from state", map[string]any{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading domain via API",
@@ -244,7 +347,7 @@ func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	_, err = r.convertFromApiModel(d, &state)
+	_, err = r.convertFromApiModel(d, &state.DomainModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error converting domain received from API to internal model",
@@ -254,6 +357,9 @@ func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -279,7 +385,7 @@ func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest,
 	payload.Hostname = "" // not allowed to update hostname
 	payload.Port = 0      // not allowed to update port
 
-	_, err = r.client.UpdateDomain(state.Id.ValueString(), payload)
+	_, err = r.client.UpdateDomain(ctx, state.Id.ValueString(), payload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating domain via API",
@@ -288,7 +394,7 @@ func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	d, err := r.client.GetDomain(state.Id.ValueString())
+	d, err := r.client.GetDomain(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading domain via API",
@@ -297,7 +403,7 @@ func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	_, err = r.convertFromApiModel(d, &plan)
+	_, err = r.convertFromApiModel(d, &plan.DomainModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error converting domain received from API to internal model",
@@ -307,6 +413,49 @@ func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultDomainVerificationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForVerification(ctx, &plan, updateTimeout, &resp.State, &resp.Diagnostics)
+}
+
+// waitForVerification polls the domain until it was verified if wait_for_verification is true.
+// The state is updated with the last domain received from the API, also if waiting failed.
+func (r *domainResource) waitForVerification(ctx context.Context, plan *DomainResourceModel, timeout time.Duration, state *tfsdk.State, diags *diag.Diagnostics) {
+	if !BoolWithFalseDefault(plan.WaitForVerification) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	d, err := r.client.WaitForDomainVerification(ctx, plan.Id.ValueString(), client.DefaultWaitOptions())
+	if d != nil {
+		if _, convErr := r.convertFromApiModel(d, &plan.DomainModel); convErr != nil {
+			diags.AddError(
+				"Error converting domain received from API to internal model",
+				"Could not convert domain, unexpected error: "+convErr.Error())
+			return
+		}
+		diags.Append(state.Set(ctx, plan)...)
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Error waiting for domain verification",
+			fmt.Sprintf("Domain %s was not verified: %s. Make sure the domain challenge can be passed and apply again.", plan.Hostname.ValueString(), err.Error()),
+		)
+	}
 }
 
 func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -317,8 +466,8 @@ func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	var _, err = r.client.DeleteDomain(state.Id.ValueString())
-	if err != nil {
+	var _, err = r.client.DeleteDomain(ctx, state.Id.ValueString())
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error deleting domain via API",
 			"Could not delete domain, unexpected error: "+err.Error(),
@@ -330,6 +479,10 @@ func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	resp.Diagnostics.Append(diags...)
 }
 
+func (r *domainResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	idIdentitySchema(ctx, req, resp)
+}
+
 func (r *domainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
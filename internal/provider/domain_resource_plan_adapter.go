@@ -7,24 +7,32 @@ import (
 	"terraform-provider-discue/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 func (r *domainResource) convertToApiModel(_ context.Context, plan *DomainResourceModel) (client.DomainRequest, error) {
 	return client.DomainRequest{
-		Alias:    plan.Alias.ValueString(),
-		Hostname: plan.Hostname.ValueString(),
-		Port:     convertStringToNumber(plan.Port.String()),
+		Alias:         plan.Alias.ValueString(),
+		Hostname:      plan.Hostname.ValueString(),
+		Port:          convertStringToNumber(plan.Port.String()),
+		ChallengeType: plan.ChallengeType.ValueString(),
 	}, nil
 }
 
-func (r *domainResource) convertFromApiModel(d *client.DomainResponse, plan *DomainResourceModel) (*DomainResourceModel, error) {
+func (r *domainResource) convertFromApiModel(d *client.DomainResponse, plan *DomainModel) (*DomainModel, error) {
 	plan.Id = types.StringValue(d.Id)
 	plan.Alias = types.StringValue(d.Alias)
 	plan.Port = types.Int32Value(d.Port)
 	plan.Hostname = types.StringValue(d.Hostname)
 
+	// domains created before the dns challenge was introduced do not have a challenge type
+	plan.ChallengeType = types.StringValue(client.DomainChallengeTypeHttps)
+	if d.ChallengeType != "" {
+		plan.ChallengeType = types.StringValue(d.ChallengeType)
+	}
+
 	var err error
 	plan.Verification, err = convertDomainVerification(d)
 	if err != nil {
@@ -54,33 +62,64 @@ func convertDomainVerification(d *client.DomainResponse) (basetypes.ObjectValue,
 }
 
 func convertChallenges(d *client.DomainResponse) (basetypes.ObjectValue, error) {
+	httpsChallengeAttrTypes := map[string]attr.Type{
+		"file_content": types.StringType,
+		"file_name":    types.StringType,
+		"context_path": types.StringType,
+		"created_at":   types.Int64Type,
+		"expires_at":   types.Int64Type,
+	}
+
+	dnsChallengeAttrTypes := map[string]attr.Type{
+		"record_name":  types.StringType,
+		"record_type":  types.StringType,
+		"record_value": types.StringType,
+		"created_at":   types.Int64Type,
+		"expires_at":   types.Int64Type,
+	}
+
 	domainChallengeAttrTypes := map[string]attr.Type{
-		"https": basetypes.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"file_content": types.StringType,
-				"file_name":    types.StringType,
-				"context_path": types.StringType,
-				"created_at":   types.Int64Type,
-				"expires_at":   types.Int64Type,
-			},
-		},
+		"https": basetypes.ObjectType{AttrTypes: httpsChallengeAttrTypes},
+		"dns":   basetypes.ObjectType{AttrTypes: dnsChallengeAttrTypes},
 	}
 
-	httpChallengeAttrValues := map[string]attr.Value{
-		"file_content": types.StringValue(d.Challenge.Https.FileContent),
-		"file_name":    types.StringValue(d.Challenge.Https.FileName),
-		"context_path": types.StringValue(d.Challenge.Https.ContextPath),
-		"created_at":   types.Int64Value(d.Challenge.Https.CreatedAt),
-		"expires_at":   types.Int64Value(d.Challenge.Https.ExpiresAt),
+	httpsChallengeObjVal := basetypes.NewObjectNull(httpsChallengeAttrTypes)
+	dnsChallengeObjVal := basetypes.NewObjectNull(dnsChallengeAttrTypes)
+
+	var diags diag.Diagnostics
+	if d.Challenge != nil && d.Challenge.Https != nil {
+		httpsChallengeAttrValues := map[string]attr.Value{
+			"file_content": types.StringValue(d.Challenge.Https.FileContent),
+			"file_name":    types.StringValue(d.Challenge.Https.FileName),
+			"context_path": types.StringValue(d.Challenge.Https.ContextPath),
+			"created_at":   types.Int64Value(d.Challenge.Https.CreatedAt),
+			"expires_at":   types.Int64Value(d.Challenge.Https.ExpiresAt),
+		}
+
+		httpsChallengeObjVal, diags = basetypes.NewObjectValue(httpsChallengeAttrTypes, httpsChallengeAttrValues)
+		if diags.HasError() {
+			return basetypes.ObjectValue{}, DiagsToStructuredError("Unable to create object value for https challenge", diags)
+		}
 	}
 
-	httpChallengeObjVal, diags := basetypes.NewObjectValue(domainChallengeAttrTypes["https"].(basetypes.ObjectType).AttrTypes, httpChallengeAttrValues)
-	if diags.HasError() {
-		return basetypes.ObjectValue{}, DiagsToStructuredError("Unable to create object value for https challenge", diags)
+	if d.Challenge != nil && d.Challenge.Dns != nil {
+		dnsChallengeAttrValues := map[string]attr.Value{
+			"record_name":  types.StringValue(d.Challenge.Dns.RecordName),
+			"record_type":  types.StringValue(d.Challenge.Dns.RecordType),
+			"record_value": types.StringValue(d.Challenge.Dns.RecordValue),
+			"created_at":   types.Int64Value(d.Challenge.Dns.CreatedAt),
+			"expires_at":   types.Int64Value(d.Challenge.Dns.ExpiresAt),
+		}
+
+		dnsChallengeObjVal, diags = basetypes.NewObjectValue(dnsChallengeAttrTypes, dnsChallengeAttrValues)
+		if diags.HasError() {
+			return basetypes.ObjectValue{}, DiagsToStructuredError("Unable to create object value for dns challenge", diags)
+		}
 	}
 
 	domainChallengeAttrValues := map[string]attr.Value{
-		"https": httpChallengeObjVal,
+		"https": httpsChallengeObjVal,
+		"dns":   dnsChallengeObjVal,
 	}
 
 	domainChallengeObjVal, diags := basetypes.NewObjectValue(domainChallengeAttrTypes, domainChallengeAttrValues)
@@ -1,9 +1,17 @@
 package provider
 
 import (
+	"context"
+	"regexp"
+	"terraform-provider-discue/internal/client"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccDomainResource(t *testing.T) {
@@ -29,6 +37,8 @@ resource "discue_domain" "test_domain" {
 					resource.TestCheckResourceAttrSet("discue_domain.test_domain", "challenge.https.%"),
 					resource.TestCheckResourceAttrSet("discue_domain.test_domain", "challenge.https.file_content"),
 					resource.TestCheckResourceAttrSet("discue_domain.test_domain", "challenge.https.file_name"),
+					resource.TestCheckResourceAttr("discue_domain.test_domain", "challenge_type", "https"),
+					resource.TestCheckNoResourceAttr("discue_domain.test_domain", "challenge.dns.%"),
 				),
 			},
 			// ImportState testing
@@ -60,3 +70,251 @@ resource "discue_domain" "test_domain" {
 		},
 	})
 }
+
+func TestAccDomainResource_identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_domain" "test_domain" {
+  alias = "my-identified-domain"
+  hostname = "discue.io"
+  port = 443
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("discue_domain.test_domain", tfjsonpath.New("id")),
+				},
+			},
+			{
+				// test import with an import block using the identity of the resource
+				ResourceName:    "discue_domain.test_domain",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccDomainResource_disappears(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Delete the object out-of-band and expect it to be planned for re-creation
+			{
+				Config: providerConfig + `
+resource "discue_domain" "test_domain" {
+  alias = "my-deleted-domain"
+  hostname = "discue.io"
+  port = 443
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("discue_domain.test_domain", "id"),
+					testAccDeleteOutOfBand("discue_domain.test_domain", func(ctx context.Context, c *client.Client, rs *terraform.ResourceState) error {
+						_, err := c.DeleteDomain(ctx, rs.Primary.ID)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDomainResource_replace(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_domain" "test_domain" {
+  alias = "my-replaced-domain"
+  hostname = "discue.io"
+  port = 443
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_domain.test_domain", "hostname", "discue.io"),
+				),
+			},
+			{
+				// test hostname change forces replacement
+				Config: providerConfig + `
+resource "discue_domain" "test_domain" {
+  alias = "my-replaced-domain"
+  hostname = "www.discue.io"
+  port = 443
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("discue_domain.test_domain", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_domain.test_domain", "hostname", "www.discue.io"),
+				),
+			},
+			{
+				// test port change forces replacement and creates the new domain first
+				Config: providerConfig + `
+resource "discue_domain" "test_domain" {
+  alias = "my-replaced-domain"
+  hostname = "www.discue.io"
+  port = 8443
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "discue_queue" "test_queue" {
+  alias = "my-first-queue"
+}
+
+resource "discue_listener" "test_listener" {
+  queue_id = discue_queue.test_queue.id
+
+  alias = "my-listener"
+  liveness_url = "https://${discue_domain.test_domain.hostname}:${discue_domain.test_domain.port}/live"
+  notify_url = "https://${discue_domain.test_domain.hostname}:${discue_domain.test_domain.port}/notify"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("discue_domain.test_domain", plancheck.ResourceActionCreateBeforeDestroy),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_domain.test_domain", "port", "8443"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "notify_url", "https://www.discue.io:8443/notify"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDomainResource_waitForVerification(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// test error when timeout is invalid
+				Config: providerConfig + `
+resource "discue_domain" "test_domain" {
+  alias = "my-waiting-domain"
+  hostname = "discue.io"
+  port = 443
+  wait_for_verification = true
+
+  timeouts {
+    create = "soon"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Time Duration"),
+			},
+			{
+				// the test server never verifies discue.io, so waiting must time out
+				Config: providerConfig + `
+resource "discue_domain" "test_domain" {
+  alias = "my-waiting-domain"
+  hostname = "discue.io"
+  port = 443
+  wait_for_verification = true
+
+  timeouts {
+    create = "3s"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("Error waiting for domain verification"),
+			},
+			{
+				// the domain was created nonetheless and is replaced because it is tainted
+				Config: providerConfig + `
+resource "discue_domain" "test_domain" {
+  alias = "my-waiting-domain"
+  hostname = "discue.io"
+  port = 443
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("discue_domain.test_domain", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_domain.test_domain", "verification.verified", "false"),
+					resource.TestCheckNoResourceAttr("discue_domain.test_domain", "wait_for_verification"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDomainResource_dnsChallenge(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_domain" "test_domain" {
+  alias = "my-private-domain"
+  hostname = "private.discue.io"
+  port = 443
+  challenge_type = "ftp"
+}
+`,
+				ExpectError: regexp.MustCompile(`Attribute challenge_type value must be one of`),
+			},
+			{
+				Config: providerConfig + `
+resource "discue_domain" "test_domain" {
+  alias = "my-private-domain"
+  hostname = "private.discue.io"
+  port = 443
+  challenge_type = "dns"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_domain.test_domain", "challenge_type", "dns"),
+					resource.TestCheckResourceAttr("discue_domain.test_domain", "challenge.dns.record_name", "_discue-challenge.private.discue.io"),
+					resource.TestCheckResourceAttr("discue_domain.test_domain", "challenge.dns.record_type", "TXT"),
+					resource.TestCheckResourceAttrSet("discue_domain.test_domain", "challenge.dns.record_value"),
+					resource.TestCheckNoResourceAttr("discue_domain.test_domain", "challenge.https.%"),
+				),
+			},
+			{
+				ResourceName:      "discue_domain.test_domain",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("discue_domain.test_domain", plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: providerConfig + `
+resource "discue_domain" "test_domain" {
+  alias = "my-private-domain"
+  hostname = "private.discue.io"
+  port = 443
+  challenge_type = "https"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_domain.test_domain", "challenge_type", "https"),
+					resource.TestCheckResourceAttrSet("discue_domain.test_domain", "challenge.https.file_name"),
+					resource.TestCheckNoResourceAttr("discue_domain.test_domain", "challenge.dns.%"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-discue/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &domainsDataSource{}
+var _ datasource.DataSourceWithConfigure = &domainsDataSource{}
+
+func NewDomainsDataSource() datasource.DataSource {
+	return &domainsDataSource{}
+}
+
+type domainsDataSource struct {
+	client *client.Client
+}
+
+type domainsDataSourceModel struct {
+	aliasFilterModel
+	Ids     types.List    `tfsdk:"ids"`
+	Domains []DomainModel `tfsdk:"domains"`
+}
+
+// domainDataSourceAttributes returns the attributes of a domain as exposed by data sources.
+func domainDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique id of the resource.",
+		},
+		"alias": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name/alias of the resource.",
+		},
+		"hostname": schema.StringAttribute{
+			Computed:    true,
+			Description: "The target hostname that will receive messages from listeners and channels.",
+		},
+		"port": schema.Int32Attribute{
+			Computed:    true,
+			Description: "The target port the messages will be sent to.",
+		},
+		"challenge_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The challenge used to verify the domain, either https or dns.",
+		},
+		"verification": schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "Describes the status of the domain validation.",
+			Attributes: map[string]schema.Attribute{
+				"verified": schema.BoolAttribute{
+					Computed:    true,
+					Description: "True if the domain was successfully verified",
+				},
+				"verified_at": schema.Int64Attribute{
+					Computed:    true,
+					Description: "Date time in MS showing since when the domain has been verified",
+				},
+			},
+		},
+		"challenge": schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "The challenge that needs to be passed to verify the domain. Depending on the challenge type, either https or dns is set.",
+			Attributes: map[string]schema.Attribute{
+				"https": schema.SingleNestedAttribute{
+					Computed:    true,
+					Description: "All the relevant information for the client to pass the HTTP challenge.",
+					Attributes: map[string]schema.Attribute{
+						"file_content": schema.StringAttribute{
+							Computed:    true,
+							Description: "The file content we expect for the http to succeed.",
+						},
+						"file_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The file name we will request for the http challenge.",
+						},
+						"context_path": schema.StringAttribute{
+							Computed:    true,
+							Description: "The context path we will use to proceed with the domain challenge.",
+						},
+						"created_at": schema.Int64Attribute{
+							Computed:    true,
+							Description: "A timestamp representing the date time the domain challenge was created.",
+						},
+						"expires_at": schema.Int64Attribute{
+							Computed:    true,
+							Description: "A timestamp representing the date time the domain challenge will expire.",
+						},
+					},
+				},
+				"dns": schema.SingleNestedAttribute{
+					Computed:    true,
+					Description: "All the relevant information for the client to pass the DNS challenge.",
+					Attributes: map[string]schema.Attribute{
+						"record_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The fully qualified name of the DNS record we will look up.",
+						},
+						"record_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the DNS record, e.g. TXT.",
+						},
+						"record_value": schema.StringAttribute{
+							Computed:    true,
+							Description: "The value we expect the DNS record to contain.",
+						},
+						"created_at": schema.Int64Attribute{
+							Computed:    true,
+							Description: "A timestamp representing the date time the domain challenge was created.",
+						},
+						"expires_at": schema.Int64Attribute{
+							Computed:    true,
+							Description: "A timestamp representing the date time the domain challenge will expire.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *domainsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "domains"}, "_")
+}
+
+func (d *domainsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all domains of the organization. Optional filters can be used to narrow down the result, e.g. to look up domains created in other workspaces.",
+		Attributes: mergeAttributes(aliasFilterAttributes(), map[string]schema.Attribute{
+			"ids": idsAttribute(),
+			"domains": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "All domains matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: domainDataSourceAttributes(),
+				},
+			},
+		}),
+	}
+}
+
+func (d *domainsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *domainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state domainsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matchesAlias, err := state.matcher()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid domain filter", err.Error())
+		return
+	}
+
+	domains, err := d.client.ListDomains(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing domains via API",
+			"Could not list domains, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	converter := domainResource{}
+	ids := []string{}
+	state.Domains = []DomainModel{}
+	for _, domain := range domains {
+		if !matchesAlias(domain.Alias) {
+			continue
+		}
+
+		var model DomainModel
+		_, err = converter.convertFromApiModel(&domain, &model)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error converting domain received from API to internal model",
+				"Could not convert domain, unexpected error: "+err.Error())
+			return
+		}
+
+		ids = append(ids, domain.Id)
+		state.Domains = append(state.Domains, model)
+	}
+
+	state.Ids, diags = PlainStringArrayToListType(ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
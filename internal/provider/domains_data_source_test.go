@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDomainsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_domain" "shared" {
+  alias = "shared-domain"
+  hostname = "discue.io"
+  port = 443
+}
+
+data "discue_domains" "by_alias" {
+  alias = discue_domain.shared.alias
+
+  depends_on = [discue_domain.shared]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.discue_domains.by_alias", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.discue_domains.by_alias", "domains.0.id", "discue_domain.shared", "id"),
+					resource.TestCheckResourceAttr("data.discue_domains.by_alias", "domains.0.hostname", "discue.io"),
+					resource.TestCheckResourceAttr("data.discue_domains.by_alias", "domains.0.port", "443"),
+					resource.TestCheckResourceAttrPair("data.discue_domains.by_alias", "domains.0.challenge.https.file_name", "discue_domain.shared", "challenge.https.file_name"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-discue/internal/client"
+	v "terraform-provider-discue/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ datasource.DataSource = &listenerDataSource{}
+var _ datasource.DataSourceWithConfigure = &listenerDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &listenerDataSource{}
+
+func NewListenerDataSource() datasource.DataSource {
+	return &listenerDataSource{}
+}
+
+type listenerDataSource struct {
+	client *client.Client
+}
+
+func (d *listenerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "listener"}, "_")
+}
+
+func (d *listenerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single listener of a queue by id or alias.",
+		Attributes:  withLookupAttributes(listenerDataSourceAttributes()),
+	}
+	resp.Schema.Attributes["queue_id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The id of the queue the listener receives messages from.",
+		Validators: []validator.String{
+			v.ValidResourceId(""),
+		},
+	}
+}
+
+func (d *listenerDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return lookupConfigValidators()
+}
+
+func (d *listenerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *listenerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ListenerModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var l *client.ListenerResponse
+	var err error
+	if HasValue(state.Id) {
+		l, err = d.client.GetListener(ctx, state.QueueId.ValueString(), state.Id.ValueString())
+	} else {
+		var listeners []client.ListenerResponse
+		listeners, err = d.client.ListListeners(ctx, state.QueueId.ValueString())
+		if err == nil {
+			l, err = findByAlias(listeners, state.Alias.ValueString(), func(l *client.ListenerResponse) string { return l.Alias })
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading listener via API",
+			"Could not read listener, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = (&listenerResource{}).convertFromApiModel(l, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting listener received from API to internal model",
+			"Could not convert listener, unexpected error: "+err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
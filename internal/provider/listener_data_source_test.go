@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccListenerDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_queue" "test_queue" {
+  alias = "my-looked-up-queue"
+}
+
+resource "discue_listener" "test_listener" {
+  queue_id = discue_queue.test_queue.id
+
+  alias = "my-looked-up-listener"
+  liveness_url = "https://discue.io/live"
+  notify_url = "https://discue.io/notify"
+}
+
+data "discue_listener" "by_alias" {
+  queue_id = discue_queue.test_queue.id
+  alias    = discue_listener.test_listener.alias
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.discue_listener.by_alias", "id", "discue_listener.test_listener", "id"),
+					resource.TestCheckResourceAttrPair("data.discue_listener.by_alias", "queue_id", "discue_queue.test_queue", "id"),
+					resource.TestCheckResourceAttr("data.discue_listener.by_alias", "liveness_url", "https://discue.io/live"),
+					resource.TestCheckResourceAttr("data.discue_listener.by_alias", "notify_url", "https://discue.io/notify"),
+				),
+			},
+		},
+	})
+}
@@ -5,20 +5,29 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"terraform-provider-discue/internal/client"
 	v "terraform-provider-discue/internal/validators"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &listenerResource{}
 var _ resource.ResourceWithConfigure = &listenerResource{}
 var _ resource.ResourceWithImportState = &listenerResource{}
+var _ resource.ResourceWithIdentity = &listenerResource{}
 
 func NewListenerResource() resource.Resource {
 	return &listenerResource{}
@@ -28,12 +37,43 @@ type listenerResource struct {
 	client *client.Client
 }
 
+// ListenerModel contains the attributes of a listener shared by the resource and the data sources.
+type ListenerModel struct {
+	Alias         types.String           `tfsdk:"alias"`
+	Id            types.String           `tfsdk:"id"`
+	QueueId       types.String           `tfsdk:"queue_id"`
+	LivenessUrl   types.String           `tfsdk:"liveness_url"`
+	NotifyUrl     types.String           `tfsdk:"notify_url"`
+	Status        types.String           `tfsdk:"status"`
+	Delivery      *ListenerDeliveryModel `tfsdk:"delivery"`
+	Liveness      *ListenerLivenessModel `tfsdk:"liveness"`
+	LivenessState types.Object           `tfsdk:"liveness_state"`
+}
+
 type ListenerResourceModel struct {
-	Alias       types.String `tfsdk:"alias"`
-	Id          types.String `tfsdk:"id"`
-	QueueId     types.String `tfsdk:"queue_id"`
-	LivenessUrl types.String `tfsdk:"liveness_url"`
-	NotifyUrl   types.String `tfsdk:"notify_url"`
+	ListenerModel
+	SigningSecretWo      types.String `tfsdk:"signing_secret_wo"`
+	SigningSecretVersion types.Int64  `tfsdk:"signing_secret_version"`
+}
+
+type ListenerDeliveryModel struct {
+	Headers     types.Map    `tfsdk:"headers"`
+	TimeoutMs   types.Int64  `tfsdk:"timeout_ms"`
+	MaxAttempts types.Int64  `tfsdk:"max_attempts"`
+	Backoff     types.String `tfsdk:"backoff"`
+}
+
+// listenerIdentityModel identifies a listener, listeners can only be read via the queue they belong to.
+type listenerIdentityModel struct {
+	QueueId types.String `tfsdk:"queue_id"`
+	Id      types.String `tfsdk:"id"`
+}
+
+type ListenerLivenessModel struct {
+	IntervalSeconds  types.Int64 `tfsdk:"interval_seconds"`
+	TimeoutMs        types.Int64 `tfsdk:"timeout_ms"`
+	FailureThreshold types.Int64 `tfsdk:"failure_threshold"`
+	ExpectedStatus   types.Int64 `tfsdk:"expected_status"`
 }
 
 func (r *listenerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,7 +83,7 @@ func (r *listenerResource) Metadata(ctx context.Context, req resource.MetadataRe
 func (r *listenerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "Listener resource",
-		MarkdownDescription: `Listeners are the endpoint for receiving messages. Each listener has a liveness URL and a notify URL. The liveness URL is used to check whether the listener is still live. The notify URL is used to send messages to the listener. The listener will be marked as inactive if the liveness URL does not respond within a certain time frame.`,
+		MarkdownDescription: "Listeners are the endpoint for receiving messages. Each listener has a liveness URL and a notify URL. The liveness URL is used to check whether the listener is still live. The notify URL is used to send messages to the listener. The liveness URL is probed as configured in the `liveness` block and the listener is no longer live once `failure_threshold` probes failed in a row. The result of the latest probes is available in `liveness_state`.",
 		Attributes: map[string]schema.Attribute{
 			"alias": schema.StringAttribute{
 				Required:    true,
@@ -72,6 +112,15 @@ func (r *listenerResource) Schema(ctx context.Context, req resource.SchemaReques
 					),
 				},
 			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("enabled"),
+				Description: "The status of the listener. Disabled listeners will not receive messages until they are enabled again. Default is \"enabled\".",
+				Validators: []validator.String{
+					stringvalidator.OneOf("enabled", "disabled"),
+				},
+			},
 			"queue_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The id of the queue this listener will receive messages from.",
@@ -86,6 +135,146 @@ func (r *listenerResource) Schema(ctx context.Context, req resource.SchemaReques
 					v.ValidResourceId(""),
 				},
 			},
+			"liveness_state": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The result of the latest probes of the liveness URL. Null until the liveness URL was probed for the first time. Changes whenever the listener is probed, so the value in state is only as recent as the last refresh.",
+				Attributes: map[string]schema.Attribute{
+					"last_probe_at": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Date time in MS of the last probe.",
+					},
+					"last_result": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The result of the last probe. Either `success` or `failure`.",
+					},
+					"consecutive_failures": schema.Int64Attribute{
+						Computed:    true,
+						Description: "How many probes failed in a row. Reset to 0 by a successful probe.",
+					},
+					"live": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "False once `failure_threshold` probes failed in a row.",
+					},
+				},
+			},
+			"signing_secret_wo": schema.StringAttribute{
+				Optional:            true,
+				WriteOnly:           true,
+				MarkdownDescription: "The secret used to sign every message sent to the notify URL, so that receivers can reject forged requests. This is a write-only attribute that requires Terraform 1.11 or later, the secret is never stored in the plan or state. The secret is sent when the listener is created and whenever `signing_secret_version` changes. Removing the secret from the configuration does not remove it from the listener.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(16),
+					stringvalidator.AlsoRequires(path.MatchRoot("signing_secret_version")),
+				},
+			},
+			"signing_secret_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Change this value to send `signing_secret_wo` to the API again, e.g. after rotating the secret. Terraform cannot detect changes of write-only attributes by itself.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("signing_secret_wo")),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"delivery": schema.SingleNestedBlock{
+				MarkdownDescription: "Configures how messages are sent to the notify URL. Settings that are not configured use the defaults of the API, removing a setting from the block keeps its current value. Remove the block to reset all settings to the defaults of the API.",
+				Attributes: map[string]schema.Attribute{
+					"headers": schema.MapAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						Sensitive:           true,
+						MarkdownDescription: "Static headers sent with every message, e.g. to authenticate at the receiver. Marked as sensitive to prevent leakage of credentials.",
+						Validators: []validator.Map{
+							mapvalidator.SizeAtLeast(1),
+							mapvalidator.KeysAre(stringvalidator.RegexMatches(
+								regexp.MustCompile(`^[A-Za-z0-9-]+$`),
+								"must be a valid header name",
+							)),
+						},
+					},
+					"timeout_ms": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "How long to wait for the notify URL to respond, in milliseconds. Must be between 100 and 60000.",
+						Validators: []validator.Int64{
+							int64validator.Between(100, 60000),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							useNonNullStateForUnknown(),
+						},
+					},
+					"max_attempts": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "How often the delivery of a message to this listener is attempted. Must be between 1 and 100. Overrides `max_delivery_attempts` of the queue.",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 100),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							useNonNullStateForUnknown(),
+						},
+					},
+					"backoff": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "How the delay between two delivery attempts evolves. Either `fixed` to always wait `retry_backoff_seconds` of the queue, or `exponential` to double the delay with every attempt.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.ListenerDeliveryBackoffFixed, client.ListenerDeliveryBackoffExponential),
+						},
+						PlanModifiers: []planmodifier.String{
+							useNonNullStateForUnknown(),
+						},
+					},
+				},
+			},
+			"liveness": schema.SingleNestedBlock{
+				MarkdownDescription: "Configures how the liveness URL is probed. Settings that are not configured use the defaults of the API, removing a setting from the block keeps its current value. Remove the block to reset all settings to the defaults of the API.",
+				Attributes: map[string]schema.Attribute{
+					"interval_seconds": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "How often the liveness URL is probed, in seconds. Must be between 5 and 3600.",
+						Validators: []validator.Int64{
+							int64validator.Between(5, 3600),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							useNonNullStateForUnknown(),
+						},
+					},
+					"timeout_ms": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "How long to wait for the liveness URL to respond, in milliseconds. Must be between 100 and 60000. Probes that time out count as failed.",
+						Validators: []validator.Int64{
+							int64validator.Between(100, 60000),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							useNonNullStateForUnknown(),
+						},
+					},
+					"failure_threshold": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "How many probes have to fail in a row until the listener is no longer live. Must be between 1 and 10.",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 10),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							useNonNullStateForUnknown(),
+						},
+					},
+					"expected_status": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "The HTTP status code the liveness URL has to respond with for a probe to succeed. Must be between 100 and 599.",
+						Validators: []validator.Int64{
+							int64validator.Between(100, 599),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							useNonNullStateForUnknown(),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -124,7 +313,16 @@ func (r *listenerResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	d, err := r.client.CreateListener(plan.QueueId.ValueString(), payload)
+	// write-only attributes are only available in the configuration
+	var signingSecret types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("signing_secret_wo"), &signingSecret)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	payload.SigningSecret = signingSecret.ValueString()
+
+	d, err := r.client.CreateListener(ctx, plan.QueueId.ValueString(), payload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating listener via API",
@@ -133,7 +331,7 @@ func (r *listenerResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	d, err = r.client.GetListener(plan.QueueId.ValueString(), d.Id)
+	d, err = r.client.GetListener(ctx, plan.QueueId.ValueString(), d.Id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading listener via API",
@@ -142,7 +340,7 @@ func (r *listenerResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	_, err = r.convertFromApiModel(d, &plan)
+	_, err = r.convertResourceFromApiModel(d, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error converting listener received from API to internal model",
@@ -153,6 +351,9 @@ func (r *listenerResource) Create(ctx context.Context, req resource.CreateReques
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, listenerIdentityModel{QueueId: plan.QueueId, Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *listenerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -163,7 +364,12 @@ func (r *listenerResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	var d, err = r.client.GetListener(state.QueueId.ValueString(), state.Id.ValueString())
+	var d, err = r.client.GetListener(ctx, state.QueueId.ValueString(), state.Id.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Listener no longer exists, removing it from state", map[string]any{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading listener via API",
@@ -172,7 +378,7 @@ func (r *listenerResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	_, err = r.convertFromApiModel(d, &state)
+	_, err = r.convertResourceFromApiModel(d, &state)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error converting listener received from API to internal model",
@@ -182,6 +388,9 @@ func (r *listenerResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, listenerIdentityModel{QueueId: state.QueueId, Id: state.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *listenerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -205,7 +414,18 @@ func (r *listenerResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	_, err = r.client.UpdateListener(state.QueueId.ValueString(), state.Id.ValueString(), payload)
+	// only send the secret again if the user asked for it by changing the version
+	if !plan.SigningSecretVersion.Equal(state.SigningSecretVersion) {
+		var signingSecret types.String
+		diags = req.Config.GetAttribute(ctx, path.Root("signing_secret_wo"), &signingSecret)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		payload.SigningSecret = signingSecret.ValueString()
+	}
+
+	_, err = r.client.UpdateListener(ctx, state.QueueId.ValueString(), state.Id.ValueString(), payload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating listener via API",
@@ -214,7 +434,7 @@ func (r *listenerResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	d, err := r.client.GetListener(state.QueueId.ValueString(), state.Id.ValueString())
+	d, err := r.client.GetListener(ctx, state.QueueId.ValueString(), state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading listener via API",
@@ -223,7 +443,7 @@ func (r *listenerResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	_, err = r.convertFromApiModel(d, &plan)
+	_, err = r.convertResourceFromApiModel(d, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error converting listener received from API to internal model",
@@ -233,6 +453,9 @@ func (r *listenerResource) Update(ctx context.Context, req resource.UpdateReques
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, listenerIdentityModel{QueueId: plan.QueueId, Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *listenerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -243,8 +466,8 @@ func (r *listenerResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	var _, err = r.client.DeleteListener(state.QueueId.ValueString(), state.Id.ValueString())
-	if err != nil {
+	var _, err = r.client.DeleteListener(ctx, state.QueueId.ValueString(), state.Id.ValueString())
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error deleting listener via API",
 			"Could not delete listener, unexpected error: "+err.Error(),
@@ -256,22 +479,47 @@ func (r *listenerResource) Delete(ctx context.Context, req resource.DeleteReques
 	resp.Diagnostics.Append(diags...)
 }
 
-func (r *listenerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-
-	// Split the import ID into queue_id and listener_id
-	parts := strings.Split(req.ID, ",")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Unexpected Format of Import ID", fmt.Sprintf("Expected format: <queue_id>/<listener_id> and got %s", req.ID))
-		return
+func (r *listenerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"queue_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The id of the queue this listener receives messages from.",
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique id of the resource.",
+			},
+		},
 	}
+}
 
-	queueId := parts[0]
-	listenerId := parts[1]
+func (r *listenerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity listenerIdentityModel
+
+	if req.ID != "" {
+		// Split the import ID into queue_id and listener_id
+		parts := strings.Split(req.ID, ",")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			resp.Diagnostics.AddError("Unexpected Format of Import ID", fmt.Sprintf("Expected format: <queue_id>,<listener_id> and got %s", req.ID))
+			return
+		}
+
+		identity.QueueId = types.StringValue(parts[0])
+		identity.Id = types.StringValue(parts[1])
+	} else {
+		// imported with an identity instead of an import ID
+		diags := req.Identity.Get(ctx, &identity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	state := ListenerResourceModel{}
-	state.Id = types.StringValue(listenerId)
-	state.QueueId = types.StringValue(queueId)
+	state.Id = identity.Id
+	state.QueueId = identity.QueueId
+	state.LivenessState = types.ObjectNull(listenerLivenessStateAttrTypes)
 
 	diags := resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -6,23 +6,154 @@ import (
 	"context"
 	"terraform-provider-discue/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func (r *listenerResource) convertFromApiModel(d *client.ListenerResponse, plan *ListenerResourceModel) (*ListenerResourceModel, error) {
+func (r *listenerResource) convertFromApiModel(d *client.ListenerResponse, plan *ListenerModel) (*ListenerModel, error) {
 	plan.Id = types.StringValue(d.Id)
 	plan.Alias = types.StringValue(d.Alias)
 	plan.LivenessUrl = types.StringValue(d.LivenessUrl)
 	plan.NotifyUrl = types.StringValue(d.NotifyUrl)
+	plan.Status = types.StringValue(d.Status)
+
+	delivery, err := convertListenerDeliveryFromApiModel(d.Delivery)
+	if err != nil {
+		var r *ListenerModel
+		return r, err
+	}
+	plan.Delivery = delivery
+	plan.Liveness = convertListenerLivenessFromApiModel(d.Liveness)
+
+	plan.LivenessState, err = convertListenerLivenessState(d.LivenessState)
+	if err != nil {
+		var r *ListenerModel
+		return r, err
+	}
 
 	return plan, nil
 }
 
-func (r *listenerResource) convertToApiModel(_ context.Context, plan *ListenerResourceModel) (client.ListenerRequest, error) {
+func convertListenerLivenessFromApiModel(d *client.ListenerLiveness) *ListenerLivenessModel {
+	if d == nil {
+		return nil
+	}
+
+	return &ListenerLivenessModel{
+		IntervalSeconds:  types.Int64PointerValue(d.IntervalSeconds),
+		TimeoutMs:        types.Int64PointerValue(d.TimeoutMs),
+		FailureThreshold: types.Int64PointerValue(d.FailureThreshold),
+		ExpectedStatus:   types.Int64PointerValue(d.ExpectedStatus),
+	}
+}
+
+var listenerLivenessStateAttrTypes = map[string]attr.Type{
+	"last_probe_at":        types.Int64Type,
+	"last_result":          types.StringType,
+	"consecutive_failures": types.Int64Type,
+	"live":                 types.BoolType,
+}
+
+func convertListenerLivenessState(d *client.ListenerLivenessState) (types.Object, error) {
+	if d == nil {
+		return types.ObjectNull(listenerLivenessStateAttrTypes), nil
+	}
+
+	livenessStateAttrValues := map[string]attr.Value{
+		"last_probe_at":        types.Int64Value(d.LastProbeAt),
+		"last_result":          types.StringValue(d.LastResult),
+		"consecutive_failures": types.Int64Value(d.ConsecutiveFailures),
+		"live":                 types.BoolValue(d.Live),
+	}
+
+	livenessState, diags := types.ObjectValue(listenerLivenessStateAttrTypes, livenessStateAttrValues)
+	if diags.HasError() {
+		return types.ObjectNull(listenerLivenessStateAttrTypes), DiagsToStructuredError("Unable to create object value for liveness state", diags)
+	}
+	return livenessState, nil
+}
+
+// convertResourceFromApiModel converts the listener like convertFromApiModel, but keeps blocks that
+// are not configured null. The API returns its defaults for them, which would otherwise be
+// inconsistent with the planned null block.
+func (r *listenerResource) convertResourceFromApiModel(d *client.ListenerResponse, plan *ListenerResourceModel) (*ListenerResourceModel, error) {
+	deliveryConfigured := plan.Delivery != nil
+	livenessConfigured := plan.Liveness != nil
+
+	_, err := r.convertFromApiModel(d, &plan.ListenerModel)
+	if err != nil {
+		var r *ListenerResourceModel
+		return r, err
+	}
+
+	if !deliveryConfigured {
+		plan.Delivery = nil
+	}
+	if !livenessConfigured {
+		plan.Liveness = nil
+	}
+
+	return plan, nil
+}
+
+func convertListenerDeliveryFromApiModel(d *client.ListenerDelivery) (*ListenerDeliveryModel, error) {
+	if d == nil {
+		return nil, nil
+	}
+
+	headers := types.MapNull(types.StringType)
+	if d.Headers != nil {
+		elements := make(map[string]attr.Value, len(d.Headers))
+		for name, value := range d.Headers {
+			elements[name] = types.StringValue(value)
+		}
+
+		var diags diag.Diagnostics
+		headers, diags = types.MapValue(types.StringType, elements)
+		if diags.HasError() {
+			return nil, DiagsToStructuredError("Unable to create map value for delivery headers", diags)
+		}
+	}
+
+	return &ListenerDeliveryModel{
+		Headers:     headers,
+		TimeoutMs:   types.Int64PointerValue(d.TimeoutMs),
+		MaxAttempts: types.Int64PointerValue(d.MaxAttempts),
+		Backoff:     types.StringPointerValue(d.Backoff),
+	}, nil
+}
+
+func (r *listenerResource) convertToApiModel(ctx context.Context, plan *ListenerResourceModel) (client.ListenerRequest, error) {
 	req := client.ListenerRequest{
 		Alias:       plan.Alias.ValueString(),
 		LivenessUrl: plan.LivenessUrl.ValueString(),
 		NotifyUrl:   plan.NotifyUrl.ValueString(),
+		Status:      plan.Status.ValueString(),
+	}
+
+	if plan.Delivery != nil {
+		headers, err := OptionalMap(ctx, plan.Delivery.Headers)
+		if err != nil {
+			var r client.ListenerRequest
+			return r, err
+		}
+
+		req.Delivery = &client.ListenerDelivery{
+			Headers:     headers,
+			TimeoutMs:   OptionalInt64(plan.Delivery.TimeoutMs),
+			MaxAttempts: OptionalInt64(plan.Delivery.MaxAttempts),
+			Backoff:     OptionalString(plan.Delivery.Backoff),
+		}
+	}
+
+	if plan.Liveness != nil {
+		req.Liveness = &client.ListenerLiveness{
+			IntervalSeconds:  OptionalInt64(plan.Liveness.IntervalSeconds),
+			TimeoutMs:        OptionalInt64(plan.Liveness.TimeoutMs),
+			FailureThreshold: OptionalInt64(plan.Liveness.FailureThreshold),
+			ExpectedStatus:   OptionalInt64(plan.Liveness.ExpectedStatus),
+		}
 	}
 
 	return req, nil
@@ -1,12 +1,21 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
+	"strings"
+	"terraform-provider-discue/internal/client"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccListenerResource(t *testing.T) {
@@ -236,6 +245,7 @@ resource "discue_listener" "test_listener" {
 					resource.TestCheckResourceAttr("discue_listener.test_listener", "alias", "my-listener"),
 					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness_url", "https://discue.io:443/live"),
 					resource.TestCheckResourceAttr("discue_listener.test_listener", "notify_url", "https://discue.io:443/notify"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "status", "enabled"),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("discue_listener.test_listener", "id"),
 				),
@@ -377,3 +387,515 @@ resource "discue_listener" "test_listener" {
 		},
 	})
 }
+
+func TestAccListenerResource_status(t *testing.T) {
+	listenerConfig := func(status string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "discue_queue" "test_queue" {
+  alias = "my-first-queue"
+}
+
+resource "discue_listener" "test_listener" {
+  queue_id = discue_queue.test_queue.id
+
+  alias = "my-paused-listener"
+  liveness_url = "https://discue.io/live"
+  notify_url = "https://discue.io/notify"
+  status = %q
+}
+`, status)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// test error when status is not supported
+				Config:      listenerConfig("paused"),
+				ExpectError: regexp.MustCompile(`value must be one of: \["enabled" "disabled"\]`),
+			},
+			{
+				// test pause listener
+				Config: listenerConfig("disabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "status", "disabled"),
+				),
+			},
+			{
+				// test drift is detected if the listener was resumed outside of terraform
+				Config: listenerConfig("disabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						c, err := testAccClient()
+						if err != nil {
+							return err
+						}
+						rs := s.RootModule().Resources["discue_listener.test_listener"]
+						_, err = c.UpdateListener(context.Background(), rs.Primary.Attributes["queue_id"], rs.Primary.ID, client.ListenerRequest{
+							Alias:       rs.Primary.Attributes["alias"],
+							LivenessUrl: rs.Primary.Attributes["liveness_url"],
+							NotifyUrl:   rs.Primary.Attributes["notify_url"],
+							Status:      "enabled",
+						})
+						return err
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// test resume listener
+				Config: listenerConfig("enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "status", "enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccListenerResource_delivery(t *testing.T) {
+	listenerConfig := func(delivery string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "discue_queue" "test_queue" {
+  alias = "my-first-queue"
+}
+
+resource "discue_listener" "test_listener" {
+  queue_id = discue_queue.test_queue.id
+
+  alias = "my-delivering-listener"
+  liveness_url = "https://discue.io/live"
+  notify_url = "https://discue.io/notify"
+%s
+}
+`, delivery)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// test error when a header name is invalid
+				Config: listenerConfig(`
+  delivery {
+    headers = {
+      "X Api Key" = "secret"
+    }
+  }
+`),
+				ExpectError: regexp.MustCompile("must be a valid header name"),
+			},
+			{
+				// test error when the backoff is not supported
+				Config: listenerConfig(`
+  delivery {
+    backoff = "linear"
+  }
+`),
+				ExpectError: regexp.MustCompile(`value must be one of: \["fixed" "exponential"\]`),
+			},
+			{
+				// test create listener with delivery policy
+				Config: listenerConfig(`
+  delivery {
+    headers = {
+      Authorization = "Bearer secret"
+      X-Tenant      = "discue"
+    }
+    timeout_ms   = 5000
+    max_attempts = 3
+    backoff      = "exponential"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "delivery.headers.%", "2"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "delivery.headers.Authorization", "Bearer secret"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "delivery.headers.X-Tenant", "discue"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "delivery.timeout_ms", "5000"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "delivery.max_attempts", "3"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "delivery.backoff", "exponential"),
+				),
+			},
+			{
+				ResourceName:      "discue_listener.test_listener",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the delivery block is only read from the API once it is configured
+				ImportStateVerifyIgnore: []string{"delivery"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					resources := s.RootModule().Resources
+					return fmt.Sprintf("%s,%s", resources["discue_queue.test_queue"].Primary.ID, resources["discue_listener.test_listener"].Primary.ID), nil
+				},
+			},
+			{
+				// test update delivery policy, removed settings keep their value
+				Config: listenerConfig(`
+  delivery {
+    timeout_ms = 1000
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "delivery.timeout_ms", "1000"),
+					resource.TestCheckNoResourceAttr("discue_listener.test_listener", "delivery.headers.%"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "delivery.max_attempts", "3"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "delivery.backoff", "exponential"),
+				),
+			},
+			{
+				// test remove delivery policy, the defaults of the API are not kept in state
+				Config: listenerConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("discue_listener.test_listener", "delivery.timeout_ms"),
+				),
+			},
+			{
+				// test settings that are not configured use the defaults of the API
+				Config: listenerConfig(`
+  delivery {
+    max_attempts = 2
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "delivery.max_attempts", "2"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "delivery.timeout_ms", "10000"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "delivery.backoff", "fixed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccListenerResource_signingSecret(t *testing.T) {
+	listenerConfig := func(alias, signingSecret string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "discue_queue" "test_queue" {
+  alias = "my-first-queue"
+}
+
+resource "discue_listener" "test_listener" {
+  queue_id = discue_queue.test_queue.id
+
+  alias = %q
+  liveness_url = "https://discue.io/live"
+  notify_url = "https://discue.io/notify"
+%s
+}
+`, alias, signingSecret)
+	}
+
+	var signingSecretUpdatedAt int64
+	checkSigningSecret := func(expectRotated bool) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			rs := s.RootModule().Resources["discue_listener.test_listener"]
+			for name, value := range rs.Primary.Attributes {
+				if strings.HasPrefix(value, "my-signing-secret") {
+					return fmt.Errorf("signing secret was persisted in attribute %s", name)
+				}
+			}
+
+			c, err := testAccClient()
+			if err != nil {
+				return err
+			}
+			l, err := c.GetListener(context.Background(), rs.Primary.Attributes["queue_id"], rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			if l.SigningSecretUpdatedAt == 0 {
+				return fmt.Errorf("expected signing secret to be set")
+			}
+			if expectRotated && l.SigningSecretUpdatedAt == signingSecretUpdatedAt {
+				return fmt.Errorf("expected signing secret to be sent again")
+			}
+			if !expectRotated && l.SigningSecretUpdatedAt != signingSecretUpdatedAt {
+				return fmt.Errorf("expected signing secret not to be sent again")
+			}
+			signingSecretUpdatedAt = l.SigningSecretUpdatedAt
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// test error when the version is set without secret
+				Config:      listenerConfig("my-signing-listener", `signing_secret_version = 1`),
+				ExpectError: regexp.MustCompile(`Attribute "signing_secret_wo" must be specified`),
+			},
+			{
+				// test error when the secret is too short
+				Config: listenerConfig("my-signing-listener", `
+  signing_secret_wo = "short"
+  signing_secret_version = 1
+`),
+				ExpectError: regexp.MustCompile(`string length must be at least 16`),
+			},
+			{
+				// test secret is sent on create
+				Config: listenerConfig("my-signing-listener", `
+  signing_secret_wo = "my-signing-secret-1"
+  signing_secret_version = 1
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("discue_listener.test_listener", "signing_secret_wo"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "signing_secret_version", "1"),
+					checkSigningSecret(true),
+				),
+			},
+			{
+				// test secret is not sent again if the version did not change
+				Config: listenerConfig("my-renamed-signing-listener", `
+  signing_secret_wo = "my-signing-secret-1"
+  signing_secret_version = 1
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "alias", "my-renamed-signing-listener"),
+					checkSigningSecret(false),
+				),
+			},
+			{
+				// test secret is sent again after the version changed
+				Config: listenerConfig("my-renamed-signing-listener", `
+  signing_secret_wo = "my-signing-secret-2"
+  signing_secret_version = 2
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "signing_secret_version", "2"),
+					checkSigningSecret(true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccListenerResource_liveness(t *testing.T) {
+	// the test server probes the liveness url, serve it locally
+	livenessServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer livenessServer.Close()
+
+	listenerConfig := func(liveness string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "discue_queue" "test_queue" {
+  alias = "my-first-queue"
+}
+
+resource "discue_listener" "test_listener" {
+  queue_id = discue_queue.test_queue.id
+
+  alias = "my-probed-listener"
+  liveness_url = "%s/live"
+  notify_url = "https://discue.io/notify"
+%s
+}
+`, livenessServer.URL, liveness)
+	}
+
+	// give the test server time to probe the listener
+	waitForProbe := func() {
+		time.Sleep(2 * time.Second)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// test error when the interval is too short
+				Config: listenerConfig(`
+  liveness {
+    interval_seconds = 1
+  }
+`),
+				ExpectError: regexp.MustCompile(`interval_seconds\s+value must be between 5 and 3600`),
+			},
+			{
+				// test error when the expected status is not a http status code
+				Config: listenerConfig(`
+  liveness {
+    expected_status = 600
+  }
+`),
+				ExpectError: regexp.MustCompile(`expected_status\s+value must be between 100 and 599`),
+			},
+			{
+				// test create listener with liveness settings
+				Config: listenerConfig(`
+  liveness {
+    interval_seconds  = 60
+    timeout_ms        = 1000
+    failure_threshold = 2
+    expected_status   = 200
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness.interval_seconds", "60"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness.timeout_ms", "1000"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness.failure_threshold", "2"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness.expected_status", "200"),
+				),
+			},
+			{
+				// test liveness state after the liveness url was probed
+				PreConfig:    waitForProbe,
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("discue_listener.test_listener", "liveness_state.last_probe_at"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness_state.last_result", "success"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness_state.consecutive_failures", "0"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness_state.live", "true"),
+				),
+			},
+			{
+				ResourceName:      "discue_listener.test_listener",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the liveness state changes with every probe and the liveness
+				// block is only read from the API once it is configured
+				ImportStateVerifyIgnore: []string{"liveness_state", "liveness"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					resources := s.RootModule().Resources
+					return fmt.Sprintf("%s,%s", resources["discue_queue.test_queue"].Primary.ID, resources["discue_listener.test_listener"].Primary.ID), nil
+				},
+			},
+			{
+				// test update liveness settings, removed settings keep their value and
+				// the liveness url does not respond with the expected status
+				Config: listenerConfig(`
+  liveness {
+    interval_seconds  = 60
+    failure_threshold = 1
+    expected_status   = 204
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness.expected_status", "204"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness.failure_threshold", "1"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness.timeout_ms", "1000"),
+				),
+			},
+			{
+				// test liveness state after the probe failed
+				PreConfig:    waitForProbe,
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness_state.last_result", "failure"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness_state.consecutive_failures", "1"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness_state.live", "false"),
+				),
+			},
+			{
+				// test remove liveness settings, the defaults of the API are not kept in state
+				Config: listenerConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("discue_listener.test_listener", "liveness.interval_seconds"),
+				),
+			},
+			{
+				// test settings that are not configured use the defaults of the API
+				Config: listenerConfig(`
+  liveness {
+    failure_threshold = 1
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness.failure_threshold", "1"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness.interval_seconds", "30"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness.timeout_ms", "5000"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness.expected_status", "200"),
+				),
+			},
+			{
+				// test liveness state after the liveness url was probed with the defaults of the API
+				PreConfig:    waitForProbe,
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness_state.last_result", "success"),
+					resource.TestCheckResourceAttr("discue_listener.test_listener", "liveness_state.live", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccListenerResource_identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_queue" "test_queue" {
+  alias = "my-first-queue"
+}
+
+resource "discue_listener" "test_listener" {
+  queue_id = discue_queue.test_queue.id
+
+  alias = "my-identified-listener"
+  liveness_url = "https://discue.io/live"
+  notify_url = "https://discue.io/notify"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("discue_listener.test_listener", tfjsonpath.New("queue_id")),
+					statecheck.ExpectIdentityValueMatchesState("discue_listener.test_listener", tfjsonpath.New("id")),
+				},
+			},
+			{
+				// test error when the import id is not separated by a comma
+				ResourceName: "discue_listener.test_listener",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					resources := s.RootModule().Resources
+					return fmt.Sprintf("%s/%s", resources["discue_queue.test_queue"].Primary.ID, resources["discue_listener.test_listener"].Primary.ID), nil
+				},
+				ExpectError: regexp.MustCompile(`Expected format: <queue_id>,<listener_id>`),
+			},
+			{
+				// test import with an import block using the identity of the resource
+				ResourceName:    "discue_listener.test_listener",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccListenerResource_disappears(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Delete the object out-of-band and expect it to be planned for re-creation
+			{
+				Config: providerConfig + `
+resource "discue_queue" "test_queue" {
+  alias = "my-first-queue"
+}
+
+resource "discue_listener" "test_listener" {
+  queue_id = discue_queue.test_queue.id
+
+  alias = "my-deleted-listener"
+  liveness_url = "https://discue.io/live"
+  notify_url = "https://discue.io/notify"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("discue_listener.test_listener", "id"),
+					testAccDeleteOutOfBand("discue_listener.test_listener", func(ctx context.Context, c *client.Client, rs *terraform.ResourceState) error {
+						_, err := c.DeleteListener(ctx, rs.Primary.Attributes["queue_id"], rs.Primary.ID)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
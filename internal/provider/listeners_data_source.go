@@ -0,0 +1,251 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-discue/internal/client"
+	v "terraform-provider-discue/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &listenersDataSource{}
+var _ datasource.DataSourceWithConfigure = &listenersDataSource{}
+
+func NewListenersDataSource() datasource.DataSource {
+	return &listenersDataSource{}
+}
+
+type listenersDataSource struct {
+	client *client.Client
+}
+
+type listenersDataSourceModel struct {
+	aliasFilterModel
+	QueueId   types.String    `tfsdk:"queue_id"`
+	Status    types.String    `tfsdk:"status"`
+	Ids       types.List      `tfsdk:"ids"`
+	Listeners []ListenerModel `tfsdk:"listeners"`
+}
+
+// listenerDataSourceAttributes returns the attributes of a listener as exposed by data sources.
+func listenerDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique id of the resource.",
+		},
+		"alias": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name/alias of the resource.",
+		},
+		"queue_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The id of the queue this listener receives messages from.",
+		},
+		"liveness_url": schema.StringAttribute{
+			Computed:    true,
+			Description: "The URL used to check whether the listener is still live.",
+		},
+		"notify_url": schema.StringAttribute{
+			Computed:    true,
+			Description: "The URL used to send messages to the listener.",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "The status of the listener.",
+		},
+		"delivery": schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "Configures how messages are sent to the notify URL.",
+			Attributes: map[string]schema.Attribute{
+				"headers": schema.MapAttribute{
+					ElementType: types.StringType,
+					Computed:    true,
+					Sensitive:   true,
+					Description: "Static headers sent with every message.",
+				},
+				"timeout_ms": schema.Int64Attribute{
+					Computed:    true,
+					Description: "How long to wait for the notify URL to respond, in milliseconds.",
+				},
+				"max_attempts": schema.Int64Attribute{
+					Computed:    true,
+					Description: "How often the delivery of a message to this listener is attempted.",
+				},
+				"backoff": schema.StringAttribute{
+					Computed:    true,
+					Description: "How the delay between two delivery attempts evolves.",
+				},
+			},
+		},
+		"liveness": schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "Configures how the liveness URL is probed.",
+			Attributes: map[string]schema.Attribute{
+				"interval_seconds": schema.Int64Attribute{
+					Computed:    true,
+					Description: "How often the liveness URL is probed, in seconds.",
+				},
+				"timeout_ms": schema.Int64Attribute{
+					Computed:    true,
+					Description: "How long to wait for the liveness URL to respond, in milliseconds.",
+				},
+				"failure_threshold": schema.Int64Attribute{
+					Computed:    true,
+					Description: "How many probes have to fail in a row until the listener is no longer live.",
+				},
+				"expected_status": schema.Int64Attribute{
+					Computed:    true,
+					Description: "The HTTP status code the liveness URL has to respond with.",
+				},
+			},
+		},
+		"liveness_state": schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "The result of the latest probes of the liveness URL.",
+			Attributes: map[string]schema.Attribute{
+				"last_probe_at": schema.Int64Attribute{
+					Computed:    true,
+					Description: "Date time in MS of the last probe.",
+				},
+				"last_result": schema.StringAttribute{
+					Computed:    true,
+					Description: "The result of the last probe.",
+				},
+				"consecutive_failures": schema.Int64Attribute{
+					Computed:    true,
+					Description: "How many probes failed in a row.",
+				},
+				"live": schema.BoolAttribute{
+					Computed:    true,
+					Description: "False once the failure threshold was reached.",
+				},
+			},
+		},
+	}
+}
+
+func (d *listenersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "listeners"}, "_")
+}
+
+func (d *listenersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all listeners of the organization. Optional filters can be used to narrow down the result, e.g. to only return the listeners of a single queue.",
+		Attributes: mergeAttributes(aliasFilterAttributes(), map[string]schema.Attribute{
+			"queue_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return listeners of the queue with this id. If omitted, the listeners of all queues are returned.",
+				Validators: []validator.String{
+					v.ValidResourceId(""),
+				},
+			},
+			"status": statusFilterAttribute(),
+			"ids":    idsAttribute(),
+			"listeners": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "All listeners matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listenerDataSourceAttributes(),
+				},
+			},
+		}),
+	}
+}
+
+func (d *listenersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *listenersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state listenersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matchesAlias, err := state.matcher()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid listener filter", err.Error())
+		return
+	}
+
+	queueIds := []string{}
+	if HasValue(state.QueueId) {
+		queueIds = append(queueIds, state.QueueId.ValueString())
+	} else {
+		queues, err := d.client.ListQueues(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error listing queues via API",
+				"Could not list queues, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		for _, q := range queues {
+			queueIds = append(queueIds, q.Id)
+		}
+	}
+
+	converter := listenerResource{}
+	ids := []string{}
+	state.Listeners = []ListenerModel{}
+	for _, queueId := range queueIds {
+		listeners, err := d.client.ListListeners(ctx, queueId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error listing listeners via API",
+				"Could not list listeners, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		for _, l := range listeners {
+			if !matchesAlias(l.Alias) || !matchesStatus(state.Status, l.Status) {
+				continue
+			}
+
+			model := ListenerModel{QueueId: types.StringValue(queueId)}
+			_, err = converter.convertFromApiModel(&l, &model)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error converting listener received from API to internal model",
+					"Could not convert listener, unexpected error: "+err.Error())
+				return
+			}
+
+			ids = append(ids, l.Id)
+			state.Listeners = append(state.Listeners, model)
+		}
+	}
+
+	state.Ids, diags = PlainStringArrayToListType(ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
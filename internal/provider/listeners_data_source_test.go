@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccListenersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_queue" "first" {
+  alias = "listed-queue-one"
+}
+
+resource "discue_queue" "second" {
+  alias = "listed-queue-two"
+}
+
+resource "discue_listener" "first" {
+  queue_id = discue_queue.first.id
+
+  alias = "listed-listener-one"
+  liveness_url = "https://discue.io/live"
+  notify_url = "https://discue.io/notify"
+}
+
+resource "discue_listener" "second" {
+  queue_id = discue_queue.second.id
+
+  alias = "listed-listener-two"
+  liveness_url = "https://discue.io/live"
+  notify_url = "https://discue.io/notify"
+}
+
+data "discue_listeners" "by_queue" {
+  queue_id = discue_queue.second.id
+
+  depends_on = [discue_listener.first, discue_listener.second]
+}
+
+data "discue_listeners" "all_queues" {
+  alias_prefix = "listed-listener-"
+
+  depends_on = [discue_listener.first, discue_listener.second]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.discue_listeners.by_queue", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.discue_listeners.by_queue", "listeners.0.id", "discue_listener.second", "id"),
+					resource.TestCheckResourceAttrPair("data.discue_listeners.by_queue", "listeners.0.queue_id", "discue_queue.second", "id"),
+					resource.TestCheckResourceAttr("data.discue_listeners.by_queue", "listeners.0.notify_url", "https://discue.io/notify"),
+
+					resource.TestCheckResourceAttr("data.discue_listeners.all_queues", "ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("data.discue_listeners.all_queues", "ids.*", "discue_listener.first", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.discue_listeners.all_queues", "ids.*", "discue_listener.second", "id"),
+				),
+			},
+		},
+	})
+}
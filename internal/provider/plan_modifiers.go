@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+var _ planmodifier.Int64 = useNonNullStateForUnknownModifier{}
+var _ planmodifier.String = useNonNullStateForUnknownModifier{}
+
+// useNonNullStateForUnknown works like UseStateForUnknown, but keeps the planned value unknown if
+// the value in state is null, e.g. because the block of the attribute was just added. The API
+// fills in its defaults for settings that are not configured, null would not match them.
+func useNonNullStateForUnknown() useNonNullStateForUnknownModifier {
+	return useNonNullStateForUnknownModifier{}
+}
+
+type useNonNullStateForUnknownModifier struct{}
+
+func (m useNonNullStateForUnknownModifier) Description(ctx context.Context) string {
+	return "Once set, the value of this attribute in state will not change."
+}
+
+func (m useNonNullStateForUnknownModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useNonNullStateForUnknownModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+func (m useNonNullStateForUnknownModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
@@ -6,18 +6,24 @@ import (
 	"context"
 	"os"
 	"terraform-provider-discue/internal/client"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ provider.Provider = &discueProvider{}
+var _ provider.ProviderWithActions = &discueProvider{}
 
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -56,13 +62,54 @@ To learn more about about discue checkout the [website](https://www.discue.io/)
 				Required:            false,
 				MarkdownDescription: "The API endpoint used to access discue.io resources. Defaults to `https://api.discue.io.`",
 			},
+			"max_retries": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum number of times a failed request will be retried. Requests are retried if the API is rate limiting or temporarily unavailable. Defaults to `3`. Set to `0` to disable retries.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_min_wait": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The minimum time in seconds to wait before retrying a failed request. Defaults to `1`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum time in seconds to wait before retrying a failed request. A `Retry-After` header sent by the API takes precedence over the exponential backoff, but is limited to this value as well. Defaults to `30`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum number of requests per second sent to the API. The limit is shared by all resources of the provider. Defaults to no limit.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum number of requests sent to the API at the same time. The limit is shared by all resources of the provider. Defaults to no limit.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
 
 type discueProviderModel struct {
-	ApiKey      types.String `tfsdk:"api_key"`
-	ApiEndpoint types.String `tfsdk:"api_endpoint"`
+	ApiKey       types.String `tfsdk:"api_key"`
+	ApiEndpoint  types.String `tfsdk:"api_endpoint"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait types.Int64  `tfsdk:"retry_min_wait"`
+	RetryMaxWait types.Int64  `tfsdk:"retry_max_wait"`
+
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
 }
 
 func (p *discueProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -104,12 +151,42 @@ func (p *discueProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	retryOptions := client.DefaultRetryOptions()
+	if HasValue(config.MaxRetries) {
+		retryOptions.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if HasValue(config.RetryMinWait) {
+		retryOptions.MinWait = time.Duration(config.RetryMinWait.ValueInt64()) * time.Second
+	}
+	if HasValue(config.RetryMaxWait) {
+		retryOptions.MaxWait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+
+	if retryOptions.MinWait > retryOptions.MaxWait {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_wait"),
+			"Invalid discue retry configuration",
+			"The value of retry_min_wait must not be greater than the value of retry_max_wait.",
+		)
+	}
+
+	rateLimitOptions := client.RateLimitOptions{}
+	if HasValue(config.RequestsPerSecond) {
+		rateLimitOptions.RequestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+	}
+	if HasValue(config.MaxConcurrentRequests) {
+		rateLimitOptions.MaxConcurrentRequests = int(config.MaxConcurrentRequests.ValueInt64())
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Create a new client using the configuration values
-	client, err := client.NewClient(apiEndpoint, &apiKey)
+	client, err := client.NewClient(apiEndpoint, &apiKey,
+		client.WithRetryOptions(retryOptions),
+		client.WithRateLimitOptions(rateLimitOptions),
+	)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create discue API Client",
@@ -120,20 +197,42 @@ func (p *discueProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ActionData = client
 
 	tflog.Info(ctx, "Configured discue client", map[string]any{"success": true})
 }
 
 func (p *discueProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewApiKeyDataSource,
+		NewApiKeysDataSource,
+		NewDomainDataSource,
+		NewDomainsDataSource,
+		NewListenerDataSource,
+		NewListenersDataSource,
+		NewQueueDataSource,
+		NewQueuesDataSource,
+	}
 }
 
 func (p *discueProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewApiClientResource,
 		NewApiKeyResource,
+		NewChannelResource,
+		NewDomainChallengeFileResource,
 		NewDomainResource,
 		NewListenerResource,
 		NewQueueResource,
+		NewSchemaResource,
+		NewSubscriptionResource,
+		NewTopicResource,
+	}
+}
+
+func (p *discueProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewVerifyDomainAction,
 	}
 }
 
@@ -1,8 +1,15 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"terraform-provider-discue/internal/client"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 const (
@@ -25,3 +32,35 @@ var (
 		"discue": providerserver.NewProtocol6WithError(New("test")()),
 	}
 )
+
+// testAccApiEndpoint returns the endpoint of the API used by the provider under test.
+func testAccApiEndpoint() string {
+	apiEndpoint := os.Getenv("DISCUE_API_ENDPOINT")
+	if apiEndpoint == "" {
+		apiEndpoint = "http://localhost:3000"
+	}
+	return apiEndpoint
+}
+
+// testAccClient returns a client talking to the same API as the provider under test.
+func testAccClient() (*client.Client, error) {
+	apiKey := os.Getenv("DISCUE_API_KEY")
+	return client.NewClient(testAccApiEndpoint(), &apiKey)
+}
+
+// testAccDeleteOutOfBand deletes the object behind the given resource without terraform knowing about it.
+func testAccDeleteOutOfBand(resourceName string, deleteFn func(ctx context.Context, c *client.Client, rs *terraform.ResourceState) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		c, err := testAccClient()
+		if err != nil {
+			return err
+		}
+
+		return deleteFn(context.Background(), c, rs)
+	}
+}
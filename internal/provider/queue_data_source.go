@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-discue/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSource = &queueDataSource{}
+var _ datasource.DataSourceWithConfigure = &queueDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &queueDataSource{}
+
+func NewQueueDataSource() datasource.DataSource {
+	return &queueDataSource{}
+}
+
+type queueDataSource struct {
+	client *client.Client
+}
+
+func (d *queueDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "queue"}, "_")
+}
+
+func (d *queueDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single queue by id or alias, e.g. to attach listeners to a queue managed by another workspace.",
+		Attributes:  withLookupAttributes(queueDataSourceAttributes()),
+	}
+}
+
+func (d *queueDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return lookupConfigValidators()
+}
+
+func (d *queueDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *queueDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state QueueResourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var q *client.Queue
+	var err error
+	if HasValue(state.Id) {
+		q, err = d.client.GetQueue(ctx, state.Id.ValueString())
+	} else {
+		var queues []client.Queue
+		queues, err = d.client.ListQueues(ctx)
+		if err == nil {
+			q, err = findByAlias(queues, state.Alias.ValueString(), func(q *client.Queue) string { return q.Alias })
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading queue via API",
+			"Could not read queue, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = (&queueResource{}).convertFromApiModel(q, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting queue received from API to internal model",
+			"Could not convert queue, unexpected error: "+err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQueueDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// test error when neither id nor alias is set
+				Config: providerConfig + `
+data "discue_queue" "invalid" {
+}
+`,
+				ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+			},
+			{
+				// test error when both id and alias are set
+				Config: providerConfig + `
+data "discue_queue" "invalid" {
+  id    = "DCSV291zRljx4zRJ8pC9Z"
+  alias = "my-looked-up-queue"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: providerConfig + `
+resource "discue_queue" "test_queue" {
+  alias = "my-looked-up-queue"
+}
+
+data "discue_queue" "by_id" {
+  id = discue_queue.test_queue.id
+}
+
+data "discue_queue" "by_alias" {
+  alias = discue_queue.test_queue.alias
+
+  depends_on = [discue_queue.test_queue]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.discue_queue.by_id", "alias", "my-looked-up-queue"),
+					resource.TestCheckResourceAttrPair("data.discue_queue.by_alias", "id", "discue_queue.test_queue", "id"),
+				),
+			},
+			{
+				// test error when no queue has the alias
+				Config: providerConfig + `
+data "discue_queue" "missing" {
+  alias = "my-missing-queue"
+}
+`,
+				ExpectError: regexp.MustCompile("found no resource with alias"),
+			},
+		},
+	})
+}
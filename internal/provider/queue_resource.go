@@ -9,17 +9,23 @@ import (
 	"terraform-provider-discue/internal/client"
 	v "terraform-provider-discue/internal/validators"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
 	_ resource.Resource                = &queueResource{}
 	_ resource.ResourceWithConfigure   = &queueResource{}
 	_ resource.ResourceWithImportState = &queueResource{}
+	_ resource.ResourceWithIdentity    = &queueResource{}
 )
 
 func NewQueueResource() resource.Resource {
@@ -31,10 +37,21 @@ type queueResource struct {
 }
 
 type QueueResourceModel struct {
-	Alias types.String `tfsdk:"alias"`
-	Id    types.String `tfsdk:"id"`
+	Alias                   types.String `tfsdk:"alias"`
+	Id                      types.String `tfsdk:"id"`
+	MessageRetentionSeconds types.Int64  `tfsdk:"message_retention_seconds"`
+	MaxDeliveryAttempts     types.Int64  `tfsdk:"max_delivery_attempts"`
+	RetryBackoffSeconds     types.Int64  `tfsdk:"retry_backoff_seconds"`
+	DeadLetterQueueId       types.String `tfsdk:"dead_letter_queue_id"`
 }
 
+const (
+	minQueueMessageRetentionSeconds = 60
+	maxQueueMessageRetentionSeconds = 14 * 24 * 60 * 60
+	maxQueueDeliveryAttempts        = 100
+	maxQueueRetryBackoffSeconds     = 60 * 60
+)
+
 func (r *queueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "queue"}, "_")
 }
@@ -58,6 +75,47 @@ func (r *queueResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					v.ValidResourceAlias(""),
 				},
 			},
+			"message_retention_seconds": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("How long messages are kept in the queue before they expire, in seconds. Must be between %d (1 minute) and %d (14 days). If not set, the default retention of the API is used. Removing the setting from the configuration keeps the current retention.", minQueueMessageRetentionSeconds, maxQueueMessageRetentionSeconds),
+				Validators: []validator.Int64{
+					int64validator.Between(minQueueMessageRetentionSeconds, maxQueueMessageRetentionSeconds),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_delivery_attempts": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("How often the delivery of a message to a listener is attempted before the message is given up, or moved to the dead letter queue if `dead_letter_queue_id` is set. Must be between 1 and %d. If not set, the default of the API is used. Removing the setting from the configuration keeps the current value.", maxQueueDeliveryAttempts),
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxQueueDeliveryAttempts),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"retry_backoff_seconds": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("How long to wait before the first redelivery of a message, in seconds. The delay doubles with every further attempt. Must be between 1 and %d (1 hour). If not set, the default of the API is used. Removing the setting from the configuration keeps the current value.", maxQueueRetryBackoffSeconds),
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxQueueRetryBackoffSeconds),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"dead_letter_queue_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The id of the queue that receives messages which could not be delivered within `max_delivery_attempts`. Requires `max_delivery_attempts` to be set. A queue cannot be its own dead letter queue. Removing the setting from the configuration detaches the dead letter queue.",
+				Validators: []validator.String{
+					v.ValidResourceId(""),
+					stringvalidator.AlsoRequires(path.MatchRoot("max_delivery_attempts")),
+				},
+			},
 		},
 	}
 }
@@ -96,7 +154,7 @@ func (r *queueResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	q, err := r.client.CreateQueue(payload)
+	q, err := r.client.CreateQueue(ctx, payload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating queue via API",
@@ -105,7 +163,7 @@ func (r *queueResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	q, err = r.client.GetQueue(q.Id)
+	q, err = r.client.GetQueue(ctx, q.Id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading queue via API",
@@ -125,6 +183,9 @@ func (r *queueResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *queueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -135,7 +196,12 @@ func (r *queueResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	var q, err = r.client.GetQueue(state.Id.ValueString())
+	var q, err = r.client.GetQueue(ctx, state.Id.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Queue no longer exists, removing it from state", map[string]any{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading queue via API",
@@ -155,6 +221,9 @@ func (r *queueResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *queueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -178,7 +247,7 @@ func (r *queueResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	_, err = r.client.UpdateQueue(state.Id.ValueString(), payload)
+	_, err = r.client.UpdateQueue(ctx, state.Id.ValueString(), payload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating queue via API",
@@ -187,7 +256,7 @@ func (r *queueResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	q, err := r.client.GetQueue(state.Id.ValueString())
+	q, err := r.client.GetQueue(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading queue via API",
@@ -207,6 +276,9 @@ func (r *queueResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *queueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -217,8 +289,8 @@ func (r *queueResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	var _, err = r.client.DeleteQueue(state.Id.ValueString())
-	if err != nil {
+	var _, err = r.client.DeleteQueue(ctx, state.Id.ValueString())
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error deleting queue via API",
 			"Could not delete queue, unexpected error: "+err.Error(),
@@ -230,6 +302,10 @@ func (r *queueResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	resp.Diagnostics.Append(diags...)
 }
 
+func (r *queueResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	idIdentitySchema(ctx, req, resp)
+}
+
 func (r *queueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
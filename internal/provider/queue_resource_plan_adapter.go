@@ -11,13 +11,21 @@ import (
 
 func (r *queueResource) convertToApiModel(_ context.Context, plan *QueueResourceModel) (client.Queue, error) {
 	return client.Queue{
-		Alias: plan.Alias.ValueString(),
+		Alias:                   plan.Alias.ValueString(),
+		MessageRetentionSeconds: OptionalInt64(plan.MessageRetentionSeconds),
+		MaxDeliveryAttempts:     OptionalInt64(plan.MaxDeliveryAttempts),
+		RetryBackoffSeconds:     OptionalInt64(plan.RetryBackoffSeconds),
+		DeadLetterQueueId:       OptionalString(plan.DeadLetterQueueId),
 	}, nil
 }
 
 func (r *queueResource) convertFromApiModel(d *client.Queue, plan *QueueResourceModel) error {
 	plan.Id = types.StringValue(d.Id)
 	plan.Alias = types.StringValue(d.Alias)
+	plan.MessageRetentionSeconds = types.Int64PointerValue(d.MessageRetentionSeconds)
+	plan.MaxDeliveryAttempts = types.Int64PointerValue(d.MaxDeliveryAttempts)
+	plan.RetryBackoffSeconds = types.Int64PointerValue(d.RetryBackoffSeconds)
+	plan.DeadLetterQueueId = types.StringPointerValue(d.DeadLetterQueueId)
 
 	return nil
 }
@@ -1,9 +1,16 @@
 package provider
 
 import (
+	"context"
+	"regexp"
+	"terraform-provider-discue/internal/client"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccQueueResource(t *testing.T) {
@@ -51,3 +58,133 @@ resource "discue_queue" "test_queue" {
 		},
 	})
 }
+
+func TestAccQueueResource_identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_queue" "test_queue" {
+  alias = "my-identified-queue"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("discue_queue.test_queue", tfjsonpath.New("id")),
+				},
+			},
+			{
+				// test import with an import block using the identity of the resource
+				ResourceName:    "discue_queue.test_queue",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccQueueResource_disappears(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Delete the object out-of-band and expect it to be planned for re-creation
+			{
+				Config: providerConfig + `
+resource "discue_queue" "test_queue" {
+  alias = "my-deleted-queue"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("discue_queue.test_queue", "id"),
+					testAccDeleteOutOfBand("discue_queue.test_queue", func(ctx context.Context, c *client.Client, rs *terraform.ResourceState) error {
+						_, err := c.DeleteQueue(ctx, rs.Primary.ID)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccQueueResource_deliverySettings(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_queue" "test_queue" {
+  alias = "my-retaining-queue"
+  message_retention_seconds = 10
+}
+`,
+				ExpectError: regexp.MustCompile(`message_retention_seconds value must be between 60 and 1209600`),
+			},
+			{
+				Config: providerConfig + `
+resource "discue_queue" "test_queue" {
+  alias = "my-retaining-queue"
+  dead_letter_queue_id = "ICPTkrpQZTsDOEDGXuHme"
+}
+`,
+				ExpectError: regexp.MustCompile(`Attribute "max_delivery_attempts" must be specified`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "discue_queue" "dead_letters" {
+  alias = "my-dead-letter-queue"
+}
+
+resource "discue_queue" "test_queue" {
+  alias = "my-retaining-queue"
+  message_retention_seconds = 86400
+  max_delivery_attempts = 5
+  retry_backoff_seconds = 30
+  dead_letter_queue_id = discue_queue.dead_letters.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_queue.test_queue", "message_retention_seconds", "86400"),
+					resource.TestCheckResourceAttr("discue_queue.test_queue", "max_delivery_attempts", "5"),
+					resource.TestCheckResourceAttr("discue_queue.test_queue", "retry_backoff_seconds", "30"),
+					resource.TestCheckResourceAttrPair("discue_queue.test_queue", "dead_letter_queue_id", "discue_queue.dead_letters", "id"),
+					resource.TestCheckNoResourceAttr("discue_queue.dead_letters", "dead_letter_queue_id"),
+					resource.TestCheckNoResourceAttr("discue_queue.dead_letters", "max_delivery_attempts"),
+					// settings that are not configured use the defaults of the API
+					resource.TestCheckResourceAttr("discue_queue.dead_letters", "message_retention_seconds", "345600"),
+					resource.TestCheckResourceAttr("discue_queue.dead_letters", "retry_backoff_seconds", "10"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "discue_queue.test_queue",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing, removed settings keep their value and the dead letter queue is detached
+			{
+				Config: providerConfig + `
+resource "discue_queue" "dead_letters" {
+  alias = "my-dead-letter-queue"
+}
+
+resource "discue_queue" "test_queue" {
+  alias = "my-retaining-queue"
+  message_retention_seconds = 3600
+  max_delivery_attempts = 3
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_queue.test_queue", "message_retention_seconds", "3600"),
+					resource.TestCheckResourceAttr("discue_queue.test_queue", "max_delivery_attempts", "3"),
+					resource.TestCheckResourceAttr("discue_queue.test_queue", "retry_backoff_seconds", "30"),
+					resource.TestCheckNoResourceAttr("discue_queue.test_queue", "dead_letter_queue_id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-discue/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &queuesDataSource{}
+var _ datasource.DataSourceWithConfigure = &queuesDataSource{}
+
+func NewQueuesDataSource() datasource.DataSource {
+	return &queuesDataSource{}
+}
+
+type queuesDataSource struct {
+	client *client.Client
+}
+
+type queuesDataSourceModel struct {
+	aliasFilterModel
+	Ids    types.List           `tfsdk:"ids"`
+	Queues []QueueResourceModel `tfsdk:"queues"`
+}
+
+// queueDataSourceAttributes returns the attributes of a queue as exposed by data sources.
+func queueDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique id of the resource.",
+		},
+		"alias": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name/alias of the resource.",
+		},
+		"message_retention_seconds": schema.Int64Attribute{
+			Computed:    true,
+			Description: "How long messages are kept in the queue before they expire, in seconds.",
+		},
+		"max_delivery_attempts": schema.Int64Attribute{
+			Computed:    true,
+			Description: "How often the delivery of a message is attempted before it is given up.",
+		},
+		"retry_backoff_seconds": schema.Int64Attribute{
+			Computed:    true,
+			Description: "How long to wait before the first redelivery of a message, in seconds.",
+		},
+		"dead_letter_queue_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The id of the queue that receives messages which could not be delivered.",
+		},
+	}
+}
+
+func (d *queuesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "queues"}, "_")
+}
+
+func (d *queuesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all queues of the organization. Optional filters can be used to narrow down the result, e.g. to look up queues created in other workspaces.",
+		Attributes: mergeAttributes(aliasFilterAttributes(), map[string]schema.Attribute{
+			"ids": idsAttribute(),
+			"queues": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "All queues matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: queueDataSourceAttributes(),
+				},
+			},
+		}),
+	}
+}
+
+func (d *queuesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *queuesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state queuesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matchesAlias, err := state.matcher()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid queue filter", err.Error())
+		return
+	}
+
+	queues, err := d.client.ListQueues(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing queues via API",
+			"Could not list queues, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	converter := queueResource{}
+	ids := []string{}
+	state.Queues = []QueueResourceModel{}
+	for _, q := range queues {
+		if !matchesAlias(q.Alias) {
+			continue
+		}
+
+		var model QueueResourceModel
+		err = converter.convertFromApiModel(&q, &model)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error converting queue received from API to internal model",
+				"Could not convert queue, unexpected error: "+err.Error())
+			return
+		}
+
+		ids = append(ids, q.Id)
+		state.Queues = append(state.Queues, model)
+	}
+
+	state.Ids, diags = PlainStringArrayToListType(ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQueuesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// test error when alias_regex is not a valid regular expression
+				Config: providerConfig + `
+data "discue_queues" "invalid" {
+  alias_regex = "^shared-[a-z"
+}
+`,
+				ExpectError: regexp.MustCompile("must be a valid regular expression"),
+			},
+			{
+				Config: providerConfig + `
+resource "discue_queue" "shared" {
+  alias = "shared-queue-one"
+}
+
+resource "discue_queue" "other" {
+  alias = "other-queue"
+}
+
+data "discue_queues" "by_alias" {
+  alias = discue_queue.shared.alias
+
+  depends_on = [discue_queue.shared, discue_queue.other]
+}
+
+data "discue_queues" "by_prefix" {
+  alias_prefix = "shared-"
+
+  depends_on = [discue_queue.shared, discue_queue.other]
+}
+
+data "discue_queues" "by_regex" {
+  alias_regex = "^(shared|other)-queue"
+
+  depends_on = [discue_queue.shared, discue_queue.other]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.discue_queues.by_alias", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.discue_queues.by_alias", "ids.0", "discue_queue.shared", "id"),
+					resource.TestCheckResourceAttrPair("data.discue_queues.by_alias", "queues.0.id", "discue_queue.shared", "id"),
+					resource.TestCheckResourceAttr("data.discue_queues.by_alias", "queues.0.alias", "shared-queue-one"),
+
+					resource.TestCheckResourceAttr("data.discue_queues.by_prefix", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.discue_queues.by_prefix", "queues.0.alias", "shared-queue-one"),
+
+					resource.TestCheckTypeSetElemAttrPair("data.discue_queues.by_regex", "ids.*", "discue_queue.shared", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.discue_queues.by_regex", "ids.*", "discue_queue.other", "id"),
+				),
+			},
+		},
+	})
+}
@@ -40,7 +40,7 @@ func getFieldByName(itf interface{}, key string) reflect.Value {
 
 	field := v.FieldByName(key)
 	if !field.IsValid() {
-		field = v.FieldByName(uppercaseFirstCharacter(key))
+		field = v.FieldByName(snakeToPascalCase(key))
 	}
 	return field
 }
@@ -0,0 +1,265 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-discue/internal/client"
+	v "terraform-provider-discue/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &schemaResource{}
+	_ resource.ResourceWithConfigure   = &schemaResource{}
+	_ resource.ResourceWithImportState = &schemaResource{}
+	_ resource.ResourceWithIdentity    = &schemaResource{}
+)
+
+func NewSchemaResource() resource.Resource {
+	return &schemaResource{}
+}
+
+type schemaResource struct {
+	client *client.Client
+}
+
+type SchemaResourceModel struct {
+	Alias      types.String         `tfsdk:"alias"`
+	Id         types.String         `tfsdk:"id"`
+	Definition jsontypes.Normalized `tfsdk:"definition"`
+}
+
+func (r *schemaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "schema"}, "_")
+}
+
+func (r *schemaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Schemas describe the structure of messages. The `definition` is a [JSON Schema](https://json-schema.org/) document which is validated during planning already. Differences in formatting and key order between the configured document and the document returned by the API are ignored.",
+		Description:         "Schema resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique id of the resource.",
+				Validators: []validator.String{
+					v.ValidResourceId(""),
+				},
+			},
+			"alias": schema.StringAttribute{
+				Required:    true,
+				Description: "The name/alias of the resource. This should be unique.",
+				Validators: []validator.String{
+					v.ValidResourceAlias(""),
+				},
+			},
+			"definition": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Required:    true,
+				Description: "The JSON Schema document messages will be validated against. Use `jsonencode` or `file` to pass the document.",
+				Validators: []validator.String{
+					v.ValidJsonSchema(""),
+				},
+			},
+		},
+	}
+}
+
+func (r *schemaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *schemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SchemaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, err := r.convertToApiModel(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting schema to API model",
+			"Could not convert schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	d, err := r.client.CreateSchema(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating schema via API",
+			"Could not create schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	d, err = r.client.GetSchema(ctx, d.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading schema via API",
+			"Could not read schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.convertFromApiModel(d, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting schema received from API to internal model",
+			"Could not convert schema, unexpected error: "+err.Error())
+
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SchemaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var d, err = r.client.GetSchema(ctx, state.Id.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Schema no longer exists, removing it from state", map[string]any{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading schema via API",
+			"Could not read schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.convertFromApiModel(d, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting schema received from API to internal model",
+			"Could not convert schema, unexpected error: "+err.Error())
+
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SchemaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+
+	var state SchemaResourceModel
+	req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, err := r.convertToApiModel(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting schema to API model",
+			"Could not convert schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.client.UpdateSchema(ctx, state.Id.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating schema via API",
+			"Could not update schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	d, err := r.client.GetSchema(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading schema via API",
+			"Could not read schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.convertFromApiModel(d, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting schema received from API to internal model",
+			"Could not convert schema, unexpected error: "+err.Error())
+
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SchemaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var _, err = r.client.DeleteSchema(ctx, state.Id.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting schema via API",
+			"Could not delete schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	idIdentitySchema(ctx, req, resp)
+}
+
+func (r *schemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
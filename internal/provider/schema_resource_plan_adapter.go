@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"terraform-provider-discue/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *schemaResource) convertToApiModel(_ context.Context, plan *SchemaResourceModel) (client.Schema, error) {
+	return client.Schema{
+		Alias:      plan.Alias.ValueString(),
+		Definition: json.RawMessage(plan.Definition.ValueString()),
+	}, nil
+}
+
+func (r *schemaResource) convertFromApiModel(d *client.Schema, plan *SchemaResourceModel) error {
+	plan.Id = types.StringValue(d.Id)
+	plan.Alias = types.StringValue(d.Alias)
+	// the API may return the document in a different format, which
+	// jsontypes.Normalized considers semantically equal to the configured one
+	plan.Definition = jsontypes.NewNormalizedValue(string(d.Definition))
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"terraform-provider-discue/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSchemaResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// test error when definition is not valid json
+				Config: providerConfig + `
+resource "discue_schema" "test_schema" {
+  alias      = "my-first-schema"
+  definition = "{\"type\": \"object\""
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid JSON String Value"),
+			},
+			{
+				// test error when definition is not a valid json schema
+				Config: providerConfig + `
+resource "discue_schema" "test_schema" {
+  alias      = "my-first-schema"
+  definition = jsonencode({ type = "objekt" })
+}
+`,
+				ExpectError: regexp.MustCompile("must be a valid JSON Schema document"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "discue_schema" "test_schema" {
+  alias      = "my-first-schema"
+  definition = <<-EOT
+    {
+      "type": "object",
+      "required": ["id"],
+      "properties": { "id": { "type": "string" } }
+    }
+  EOT
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_schema.test_schema", "alias", "my-first-schema"),
+					resource.TestCheckResourceAttrSet("discue_schema.test_schema", "definition"),
+					resource.TestCheckResourceAttrSet("discue_schema.test_schema", "id"),
+				),
+			},
+			// The API returns the definition in a different format, which must not cause a diff
+			{
+				Config: providerConfig + `
+resource "discue_schema" "test_schema" {
+  alias      = "my-first-schema"
+  definition = <<-EOT
+    {
+      "type": "object",
+      "required": ["id"],
+      "properties": { "id": { "type": "string" } }
+    }
+  EOT
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "discue_schema.test_schema",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the API returns the definition in a different format
+				ImportStateVerifyIgnore: []string{"definition"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "discue_schema" "test_schema" {
+  alias      = "my-first-schema-with-new-alias"
+  definition = jsonencode({
+    type       = "object"
+    properties = { id = { type = "string" }, total = { type = "number" } }
+    required   = ["id", "total"]
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_schema.test_schema", "alias", "my-first-schema-with-new-alias"),
+					resource.TestMatchResourceAttr("discue_schema.test_schema", "definition", regexp.MustCompile(`"total"`)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSchemaResource_identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_schema" "test_schema" {
+  alias      = "my-identified-schema"
+  definition = jsonencode({ type = "object" })
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("discue_schema.test_schema", tfjsonpath.New("id")),
+				},
+			},
+			{
+				// test import with an import block using the identity of the resource
+				ResourceName:    "discue_schema.test_schema",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccSchemaResource_disappears(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Delete the object out-of-band and expect it to be planned for re-creation
+			{
+				Config: providerConfig + `
+resource "discue_schema" "test_schema" {
+  alias      = "my-deleted-schema"
+  definition = jsonencode({ type = "object" })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("discue_schema.test_schema", "id"),
+					testAccDeleteOutOfBand("discue_schema.test_schema", func(ctx context.Context, c *client.Client, rs *terraform.ResourceState) error {
+						_, err := c.DeleteSchema(ctx, rs.Primary.ID)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"strings"
 	"unicode"
 	"unicode/utf8"
 )
@@ -24,3 +25,12 @@ func uppercaseFirstCharacter(s string) string {
 	// Reconstruct the string with the first character uppercase
 	return string(upper) + s[size:]
 }
+
+// snakeToPascalCase converts e.g. api_clients to ApiClients
+func snakeToPascalCase(s string) string {
+	parts := strings.Split(s, "_")
+	for i, part := range parts {
+		parts[i] = uppercaseFirstCharacter(part)
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,331 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-discue/internal/client"
+	v "terraform-provider-discue/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &subscriptionResource{}
+var _ resource.ResourceWithConfigure = &subscriptionResource{}
+var _ resource.ResourceWithImportState = &subscriptionResource{}
+var _ resource.ResourceWithIdentity = &subscriptionResource{}
+
+func NewSubscriptionResource() resource.Resource {
+	return &subscriptionResource{}
+}
+
+type subscriptionResource struct {
+	client *client.Client
+}
+
+type SubscriptionResourceModel struct {
+	Alias   types.String `tfsdk:"alias"`
+	Id      types.String `tfsdk:"id"`
+	TopicId types.String `tfsdk:"topic_id"`
+	QueueId types.String `tfsdk:"queue_id"`
+	Filters types.List   `tfsdk:"filters"`
+}
+
+// subscriptionIdentityModel identifies a subscription, subscriptions can only be read via the topic they belong to.
+type subscriptionIdentityModel struct {
+	TopicId types.String `tfsdk:"topic_id"`
+	Id      types.String `tfsdk:"id"`
+}
+
+func (r *subscriptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "subscription"}, "_")
+}
+
+func (r *subscriptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Subscription resource",
+		MarkdownDescription: "Subscriptions link a topic to a queue. Every message published to the topic will be forwarded to the subscribed queue, unless it is rejected by one of the filter expressions. Changing the topic or the queue of a subscription will replace it.",
+		Attributes: map[string]schema.Attribute{
+			"alias": schema.StringAttribute{
+				Required:    true,
+				Description: "The name/alias of the resource. This should be unique.",
+				Validators: []validator.String{
+					v.ValidResourceAlias(""),
+				},
+			},
+			"topic_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the topic messages will be received from.",
+				Validators: []validator.String{
+					v.ValidResourceId(""),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"queue_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the queue messages will be forwarded to.",
+				Validators: []validator.String{
+					v.ValidResourceId(""),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filters": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Filter expressions evaluated against each message. Only messages matching all expressions will be forwarded to the queue.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 1024)),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique id of the resource.",
+				Validators: []validator.String{
+					v.ValidResourceId(""),
+				},
+			},
+		},
+	}
+}
+
+func (r *subscriptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *subscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SubscriptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, err := r.convertToApiModel(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting subscription to API model",
+			"Could not convert subscription, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	d, err := r.client.CreateSubscription(ctx, plan.TopicId.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating subscription via API",
+			"Could not create subscription, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	d, err = r.client.GetSubscription(ctx, plan.TopicId.ValueString(), d.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading subscription via API",
+			"Could not read subscription, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.convertFromApiModel(d, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting subscription received from API to internal model",
+			"Could not convert subscription, unexpected error: "+err.Error())
+
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, subscriptionIdentityModel{TopicId: plan.TopicId, Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *subscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SubscriptionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var d, err = r.client.GetSubscription(ctx, state.TopicId.ValueString(), state.Id.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Subscription no longer exists, removing it from state", map[string]any{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading subscription via API",
+			"Could not read subscription, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.convertFromApiModel(d, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting subscription received from API to internal model",
+			"Could not convert subscription, unexpected error: "+err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, subscriptionIdentityModel{TopicId: state.TopicId, Id: state.Id})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *subscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SubscriptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+
+	var state SubscriptionResourceModel
+	req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, err := r.convertToApiModel(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting subscription to API model",
+			"Could not convert subscription, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.client.UpdateSubscription(ctx, state.TopicId.ValueString(), state.Id.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating subscription via API",
+			"Could not update subscription, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	d, err := r.client.GetSubscription(ctx, state.TopicId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading subscription via API",
+			"Could not read subscription, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.convertFromApiModel(d, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting subscription received from API to internal model",
+			"Could not convert subscription, unexpected error: "+err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, subscriptionIdentityModel{TopicId: plan.TopicId, Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *subscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SubscriptionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var _, err = r.client.DeleteSubscription(ctx, state.TopicId.ValueString(), state.Id.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting subscription via API",
+			"Could not delete subscription, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *subscriptionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"topic_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The id of the topic this subscription forwards messages from.",
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The unique id of the resource.",
+			},
+		},
+	}
+}
+
+func (r *subscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity subscriptionIdentityModel
+
+	if req.ID != "" {
+		// Split the import ID into topic_id and subscription_id
+		parts := strings.Split(req.ID, ",")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			resp.Diagnostics.AddError("Unexpected Format of Import ID", fmt.Sprintf("Expected format: <topic_id>,<subscription_id> and got %s", req.ID))
+			return
+		}
+
+		identity.TopicId = types.StringValue(parts[0])
+		identity.Id = types.StringValue(parts[1])
+	} else {
+		// imported with an identity instead of an import ID
+		diags := req.Identity.Get(ctx, &identity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state := SubscriptionResourceModel{}
+	state.Id = identity.Id
+	state.TopicId = identity.TopicId
+	state.Filters = types.ListNull(types.StringType)
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-discue/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *subscriptionResource) convertFromApiModel(d *client.SubscriptionResponse, plan *SubscriptionResourceModel) (*SubscriptionResourceModel, error) {
+	plan.Id = types.StringValue(d.Id)
+	plan.Alias = types.StringValue(d.Alias)
+	plan.QueueId = types.StringValue(d.QueueId)
+
+	if len(d.Filters) == 0 {
+		plan.Filters = types.ListNull(types.StringType)
+	} else {
+		filters, diags := PlainStringArrayToListType(d.Filters)
+		if diags.HasError() {
+			return nil, DiagsToStructuredError("Unable to convert filters", diags)
+		}
+		plan.Filters = filters
+	}
+
+	return plan, nil
+}
+
+func (r *subscriptionResource) convertToApiModel(_ context.Context, plan *SubscriptionResourceModel) (client.SubscriptionRequest, error) {
+	req := client.SubscriptionRequest{
+		Alias:   plan.Alias.ValueString(),
+		QueueId: plan.QueueId.ValueString(),
+		Filters: OptionalList(plan.Filters),
+	}
+
+	return req, nil
+}
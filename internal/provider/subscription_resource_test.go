@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-discue/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+const subscriptionTestDependencies = `
+resource "discue_topic" "test_topic" {
+  alias = "my-first-topic"
+}
+
+resource "discue_queue" "test_queue" {
+  alias = "my-first-queue"
+}
+
+resource "discue_queue" "test_queue_other" {
+  alias = "my-other-queue"
+}
+`
+
+func TestAccSubscriptionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// test error when topic_id is not a valid id
+				Config: providerConfig + subscriptionTestDependencies + `
+resource "discue_subscription" "test_subscription" {
+  topic_id = "not-an-id"
+  queue_id = discue_queue.test_queue.id
+
+  alias = "my-subscription"
+}
+`,
+				ExpectError: regexp.MustCompile("topic_id"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + subscriptionTestDependencies + `
+resource "discue_subscription" "test_subscription" {
+  topic_id = discue_topic.test_topic.id
+  queue_id = discue_queue.test_queue.id
+
+  alias   = "my-subscription"
+  filters = ["type == 'order.created'"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_subscription.test_subscription", "alias", "my-subscription"),
+					resource.TestCheckResourceAttrPair("discue_subscription.test_subscription", "topic_id", "discue_topic.test_topic", "id"),
+					resource.TestCheckResourceAttrPair("discue_subscription.test_subscription", "queue_id", "discue_queue.test_queue", "id"),
+					resource.TestCheckResourceAttr("discue_subscription.test_subscription", "filters.#", "1"),
+					resource.TestCheckResourceAttr("discue_subscription.test_subscription", "filters.0", "type == 'order.created'"),
+					resource.TestCheckResourceAttrSet("discue_subscription.test_subscription", "id"),
+				),
+			},
+			{
+				// test error when the topic id of the import id is empty
+				ResourceName:  "discue_subscription.test_subscription",
+				ImportState:   true,
+				ImportStateId: ",abc",
+				ExpectError:   regexp.MustCompile(`Expected format: <topic_id>,<subscription_id>`),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "discue_subscription.test_subscription",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["discue_subscription.test_subscription"]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", "discue_subscription.test_subscription")
+					}
+					return fmt.Sprintf("%s,%s", rs.Primary.Attributes["topic_id"], rs.Primary.ID), nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + subscriptionTestDependencies + `
+resource "discue_subscription" "test_subscription" {
+  topic_id = discue_topic.test_topic.id
+  queue_id = discue_queue.test_queue.id
+
+  alias = "my-subscription-with-new-alias"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("discue_subscription.test_subscription", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_subscription.test_subscription", "alias", "my-subscription-with-new-alias"),
+					resource.TestCheckNoResourceAttr("discue_subscription.test_subscription", "filters"),
+				),
+			},
+			// Replace when the queue changes
+			{
+				Config: providerConfig + subscriptionTestDependencies + `
+resource "discue_subscription" "test_subscription" {
+  topic_id = discue_topic.test_topic.id
+  queue_id = discue_queue.test_queue_other.id
+
+  alias = "my-subscription-with-new-alias"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("discue_subscription.test_subscription", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("discue_subscription.test_subscription", "queue_id", "discue_queue.test_queue_other", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSubscriptionResource_identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + subscriptionTestDependencies + `
+resource "discue_subscription" "test_subscription" {
+  topic_id = discue_topic.test_topic.id
+  queue_id = discue_queue.test_queue.id
+
+  alias = "my-identified-subscription"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("discue_subscription.test_subscription", tfjsonpath.New("topic_id")),
+					statecheck.ExpectIdentityValueMatchesState("discue_subscription.test_subscription", tfjsonpath.New("id")),
+				},
+			},
+			{
+				// test import with an import block using the identity of the resource
+				ResourceName:    "discue_subscription.test_subscription",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccSubscriptionResource_disappears(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Delete the object out-of-band and expect it to be planned for re-creation
+			{
+				Config: providerConfig + subscriptionTestDependencies + `
+resource "discue_subscription" "test_subscription" {
+  topic_id = discue_topic.test_topic.id
+  queue_id = discue_queue.test_queue.id
+
+  alias = "my-deleted-subscription"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("discue_subscription.test_subscription", "id"),
+					testAccDeleteOutOfBand("discue_subscription.test_subscription", func(ctx context.Context, c *client.Client, rs *terraform.ResourceState) error {
+						_, err := c.DeleteSubscription(ctx, rs.Primary.Attributes["topic_id"], rs.Primary.ID)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
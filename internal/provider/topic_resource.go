@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-discue/internal/client"
+	v "terraform-provider-discue/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &topicResource{}
+	_ resource.ResourceWithConfigure   = &topicResource{}
+	_ resource.ResourceWithImportState = &topicResource{}
+	_ resource.ResourceWithIdentity    = &topicResource{}
+)
+
+func NewTopicResource() resource.Resource {
+	return &topicResource{}
+}
+
+type topicResource struct {
+	client *client.Client
+}
+
+type TopicResourceModel struct {
+	Alias types.String `tfsdk:"alias"`
+	Id    types.String `tfsdk:"id"`
+}
+
+func (r *topicResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "topic"}, "_")
+}
+
+func (r *topicResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A topic resource is the entry point for publish/subscribe messaging. Messages published to a topic are fanned out to all queues subscribed to it. See also: [topics](https://docs.discue.io/topics/)",
+		Description:         "Topic resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique id of the resource.",
+				Validators: []validator.String{
+					v.ValidResourceId(""),
+				},
+			},
+			"alias": schema.StringAttribute{
+				Required:    true,
+				Description: "The name/alias of the resource. This should be unique.",
+				Validators: []validator.String{
+					v.ValidResourceAlias(""),
+				},
+			},
+		},
+	}
+}
+
+func (r *topicResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *topicResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TopicResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, err := r.convertToApiModel(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting topic to API model",
+			"Could not convert topic, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	t, err := r.client.CreateTopic(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating topic via API",
+			"Could not create topic, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	t, err = r.client.GetTopic(ctx, t.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading topic via API",
+			"Could not read topic, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.convertFromApiModel(t, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting topic received from API to internal model",
+			"Could not convert topic, unexpected error: "+err.Error())
+
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *topicResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TopicResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var t, err = r.client.GetTopic(ctx, state.Id.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Topic no longer exists, removing it from state", map[string]any{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading topic via API",
+			"Could not read topic, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.convertFromApiModel(t, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting topic received from API to internal model",
+			"Could not convert topic, unexpected error: "+err.Error())
+
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: state.Id})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *topicResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TopicResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+
+	var state TopicResourceModel
+	req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, err := r.convertToApiModel(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting topic to API model",
+			"Could not convert topic, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.client.UpdateTopic(ctx, state.Id.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating topic via API",
+			"Could not update topic, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	t, err := r.client.GetTopic(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading topic via API",
+			"Could not read topic, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = r.convertFromApiModel(t, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting topic received from API to internal model",
+			"Could not convert topic, unexpected error: "+err.Error())
+
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, idIdentityModel{Id: plan.Id})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *topicResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TopicResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var _, err = r.client.DeleteTopic(ctx, state.Id.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting topic via API",
+			"Could not delete topic, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *topicResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	idIdentitySchema(ctx, req, resp)
+}
+
+func (r *topicResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-discue/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *topicResource) convertToApiModel(_ context.Context, plan *TopicResourceModel) (client.Topic, error) {
+	return client.Topic{
+		Alias: plan.Alias.ValueString(),
+	}, nil
+}
+
+func (r *topicResource) convertFromApiModel(d *client.Topic, plan *TopicResourceModel) error {
+	plan.Id = types.StringValue(d.Id)
+	plan.Alias = types.StringValue(d.Alias)
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-discue/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTopicResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "discue_topic" "test_topic" {
+  alias = "my-first-topic"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify number of items
+					resource.TestCheckResourceAttr("discue_topic.test_topic", "alias", "my-first-topic"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("discue_topic.test_topic", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "discue_topic.test_topic",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The last_updated attribute does not exist in the HashiCups
+				// API, therefore there is no value for it during import.
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "discue_topic" "test_topic" {
+  alias = "my-first-topic-with-new-alias"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify number of items
+					resource.TestCheckResourceAttr("discue_topic.test_topic", "alias", "my-first-topic-with-new-alias"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("discue_topic.test_topic", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTopicResource_identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "discue_topic" "test_topic" {
+  alias = "my-identified-topic"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("discue_topic.test_topic", tfjsonpath.New("id")),
+				},
+			},
+			{
+				// test import with an import block using the identity of the resource
+				ResourceName:    "discue_topic.test_topic",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccTopicResource_disappears(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Delete the object out-of-band and expect it to be planned for re-creation
+			{
+				Config: providerConfig + `
+resource "discue_topic" "test_topic" {
+  alias = "my-deleted-topic"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("discue_topic.test_topic", "id"),
+					testAccDeleteOutOfBand("discue_topic.test_topic", func(ctx context.Context, c *client.Client, rs *terraform.ResourceState) error {
+						_, err := c.DeleteTopic(ctx, rs.Primary.ID)
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
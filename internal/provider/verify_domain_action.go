@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-discue/internal/client"
+	v "terraform-provider-discue/internal/validators"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.Action = &verifyDomainAction{}
+var _ action.ActionWithConfigure = &verifyDomainAction{}
+
+const verifyDomainTimeout = 10 * time.Minute
+
+func NewVerifyDomainAction() action.Action {
+	return &verifyDomainAction{}
+}
+
+type verifyDomainAction struct {
+	client *client.Client
+}
+
+type verifyDomainActionModel struct {
+	DomainId types.String `tfsdk:"domain_id"`
+}
+
+func (a *verifyDomainAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = strings.Join([]string{req.ProviderTypeName, "verify_domain"}, "_")
+}
+
+func (a *verifyDomainAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Asks the API to check the challenge of a domain again, e.g. after the challenge file was uploaded to the web server of the domain. The action waits until the check finished and fails if the domain could not be verified. Requires Terraform 1.14 or later.",
+		Attributes: map[string]schema.Attribute{
+			"domain_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the domain to verify.",
+				Validators: []validator.String{
+					v.ValidResourceId(""),
+				},
+			},
+		},
+	}
+}
+
+func (a *verifyDomainAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *verifyDomainAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config verifyDomainActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainId := config.DomainId.ValueString()
+
+	d, err := a.client.VerifyDomain(ctx, domainId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error verifying domain via API",
+			"Could not verify domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Requested verification of domain %s (%s)", d.Alias, d.Hostname),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, verifyDomainTimeout)
+	defer cancel()
+
+	options := client.DefaultWaitOptions()
+	options.Progress = func(d *client.DomainResponse) {
+		if d.Verification.Pending() {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Waiting for the challenge of domain %s to be checked", d.Hostname),
+			})
+		}
+	}
+
+	d, err = a.client.WaitForDomainVerificationResult(ctx, domainId, options)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for domain verification",
+			"Could not read verification result of domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if d.Verification == nil || !d.Verification.Verified {
+		reason := "the challenge could not be passed"
+		if d.Verification != nil && d.Verification.Reason != "" {
+			reason = d.Verification.Reason
+		}
+		resp.Diagnostics.AddError(
+			"Domain verification failed",
+			fmt.Sprintf("Domain %s was not verified: %s.", d.Hostname, reason),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Domain %s was verified", d.Hostname),
+	})
+}
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// testAccChallengeServer starts a web server that serves the challenge file
+// the test server hands out for every domain with the given content
+func testAccChallengeServer(t *testing.T, content string) (string, string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/acme-challenge/abcd/challenge-file.txt", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(content))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return "localhost", u.Port()
+}
+
+// testAccStubResolver starts a dns server answering TXT queries with the given records at the
+// address the test server uses to check dns challenges
+func testAccStubResolver(t *testing.T, records map[string]string) {
+	address := os.Getenv("DNS_RESOLVER_ADDRESS")
+	if address == "" {
+		address = "127.0.0.1:5354"
+	}
+	conn, err := net.ListenPacket("udp", address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if res, err := testAccStubResolverAnswer(buf[:n], records); err == nil {
+				_, _ = conn.WriteTo(res, addr)
+			}
+		}
+	}()
+}
+
+func testAccStubResolverAnswer(req []byte, records map[string]string) ([]byte, error) {
+	var p dnsmessage.Parser
+	header, err := p.Start(req)
+	if err != nil {
+		return nil, err
+	}
+	question, err := p.Question()
+	if err != nil {
+		return nil, err
+	}
+
+	value, found := records[question.Name.String()]
+	header.Response = true
+	header.Authoritative = true
+	if !found {
+		header.RCode = dnsmessage.RCodeNameError
+	}
+
+	b := dnsmessage.NewBuilder(nil, header)
+	b.EnableCompression()
+	if err := b.StartQuestions(); err != nil {
+		return nil, err
+	}
+	if err := b.Question(question); err != nil {
+		return nil, err
+	}
+	if err := b.StartAnswers(); err != nil {
+		return nil, err
+	}
+	if found && question.Type == dnsmessage.TypeTXT {
+		err = b.TXTResource(dnsmessage.ResourceHeader{Name: question.Name, Class: dnsmessage.ClassINET, TTL: 60}, dnsmessage.TXTResource{TXT: []string{value}})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b.Finish()
+}
+
+func testAccVerifyDomainActionConfig(hostname, port string) string {
+	return testAccVerifyDomainActionConfigWithChallengeType(hostname, port, "https")
+}
+
+func testAccVerifyDomainActionConfigWithChallengeType(hostname, port, challengeType string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "discue_domain" "test_domain" {
+  alias = "my-domain-to-verify"
+  hostname = %[1]q
+  port = %[2]s
+  challenge_type = %[3]q
+}
+
+action "discue_verify_domain" "verify" {
+  config {
+    domain_id = discue_domain.test_domain.id
+  }
+}
+
+resource "terraform_data" "verify_trigger" {
+  input = discue_domain.test_domain.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.discue_verify_domain.verify]
+    }
+  }
+}
+`, hostname, port, challengeType)
+}
+
+func TestAccVerifyDomainAction(t *testing.T) {
+	hostname, port := testAccChallengeServer(t, "challenge-content")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifyDomainActionConfig(hostname, port),
+			},
+			{
+				// the domain in state was read before the action was invoked
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_domain.test_domain", "verification.verified", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifyDomainAction_failed(t *testing.T) {
+	hostname, port := testAccChallengeServer(t, "wrong-content")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVerifyDomainActionConfig(hostname, port),
+				ExpectError: regexp.MustCompile(`challenge file\s+content does not match`),
+			},
+		},
+	})
+}
+
+func TestAccVerifyDomainAction_dns(t *testing.T) {
+	testAccStubResolver(t, map[string]string{
+		"_discue-challenge.private.discue.io.": "dns-challenge-content",
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifyDomainActionConfigWithChallengeType("private.discue.io", "443", "dns"),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("discue_domain.test_domain", "verification.verified", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifyDomainAction_dnsFailed(t *testing.T) {
+	testAccStubResolver(t, map[string]string{
+		"_discue-challenge.private.discue.io.": "wrong-content",
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVerifyDomainActionConfigWithChallengeType("private.discue.io", "443", "dns"),
+				ExpectError: regexp.MustCompile(`TXT\s+record does not contain the\s+challenge`),
+			},
+		},
+	})
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+var _ validator.String = jsonSchemaValidator{}
+
+// the compiler needs a location to resolve relative references against
+const jsonSchemaLocation = "urn:discue:schema"
+
+// jsonSchemaValidator validates that a string Attribute's value is a valid JSON Schema document.
+type jsonSchemaValidator struct {
+	message string
+}
+
+// Description describes the validation in plain text formatting.
+func (validator jsonSchemaValidator) Description(_ context.Context) string {
+	if validator.message != "" {
+		return validator.message
+	}
+	return "must be a valid JSON Schema document"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator jsonSchemaValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v jsonSchemaValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if err := compileJsonSchema(value); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			fmt.Sprintf("%s (%s)", v.Description(ctx), err),
+			value,
+		))
+	}
+}
+
+func compileJsonSchema(value string) error {
+	document, err := jsonschema.UnmarshalJSON(strings.NewReader(value))
+	if err != nil {
+		return err
+	}
+
+	compiler := jsonschema.NewCompiler()
+	// the default loader would read referenced local files, only the document itself may be used
+	compiler.UseLoader(noExternalLoader{})
+	if err := compiler.AddResource(jsonSchemaLocation, document); err != nil {
+		return err
+	}
+
+	_, err = compiler.Compile(jsonSchemaLocation)
+	return err
+}
+
+// noExternalLoader rejects every external reference. Meta schemas are built into the compiler
+// and do not need a loader.
+type noExternalLoader struct{}
+
+func (noExternalLoader) Load(url string) (any, error) {
+	return nil, fmt.Errorf("external references are not supported")
+}
+
+// Returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a JSON document
+//   - Is a valid JSON Schema according to its meta schema (draft 2020-12 by default)
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Optionally an error message can be provided
+func ValidJsonSchema(message string) validator.String {
+	return jsonSchemaValidator{
+		message: message,
+	}
+}
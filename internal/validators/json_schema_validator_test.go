@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJsonSchemaValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid schema": {
+			val: types.StringValue(`{"type": "object", "properties": {"id": {"type": "string"}}, "required": ["id"]}`),
+		},
+		"valid schema with local reference": {
+			val: types.StringValue(`{"$defs": {"id": {"type": "string"}}, "properties": {"id": {"$ref": "#/$defs/id"}}}`),
+		},
+		"valid boolean schema": {
+			val: types.StringValue(`true`),
+		},
+		"invalid json": {
+			val:         types.StringValue(`{"type": "object"`),
+			expectError: true,
+		},
+		"invalid type": {
+			val:         types.StringValue(`{"type": "objekt"}`),
+			expectError: true,
+		},
+		"invalid required": {
+			val:         types.StringValue(`{"type": "object", "required": "id"}`),
+			expectError: true,
+		},
+		"unresolvable reference": {
+			val:         types.StringValue(`{"properties": {"id": {"$ref": "#/$defs/missing"}}}`),
+			expectError: true,
+		},
+		"external reference": {
+			val:         types.StringValue(`{"properties": {"id": {"$ref": "file:///etc/passwd"}}}`),
+			expectError: true,
+		},
+		"external http reference": {
+			val:         types.StringValue(`{"$ref": "https://example.com/schema.json"}`),
+			expectError: true,
+		},
+		"valid schema with explicit meta schema": {
+			val: types.StringValue(`{"$schema": "https://json-schema.org/draft/2020-12/schema", "type": "string"}`),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			ValidJsonSchema("").ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
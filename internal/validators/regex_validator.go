@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = regexValidator{}
+
+// regexValidator validates that a string Attribute's value is a valid regular expression.
+type regexValidator struct {
+	message string
+}
+
+// Description describes the validation in plain text formatting.
+func (validator regexValidator) Description(_ context.Context) string {
+	if validator.message != "" {
+		return validator.message
+	}
+	return "must be a valid regular expression (RE2 syntax)"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator regexValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v regexValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if _, err := regexp.Compile(value); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+// Returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a valid regular expression according to https://pkg.go.dev/regexp/syntax
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+// Optionally an error message can be provided
+func ValidRegex(message string) validator.String {
+	return regexValidator{
+		message: message,
+	}
+}
@@ -1,10 +1,18 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"io"
 	"log"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -15,12 +23,15 @@ type store struct {
 	// maps: resource -> id -> object
 	data map[string]map[string]map[string]any
 	seq  map[string]int
+	// maps: resource -> ids in order of creation
+	order map[string][]string
 }
 
 func newStore() *store {
 	return &store{
-		data: map[string]map[string]map[string]any{},
-		seq:  map[string]int{},
+		data:  map[string]map[string]map[string]any{},
+		seq:   map[string]int{},
+		order: map[string][]string{},
 	}
 }
 
@@ -39,9 +50,21 @@ func (s *store) create(resource string, obj map[string]any) map[string]any {
 	objCopy["id"] = id
 	objCopy["created_at"] = time.Now().Unix()
 	s.data[resource][id] = objCopy
+	s.order[resource] = append(s.order[resource], id)
 	return objCopy
 }
 
+const apiKeyPrefixLength = 8
+
+// generateApiKey creates a random api key
+func generateApiKey() string {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		log.Fatal(err)
+	}
+	return hex.EncodeToString(b)
+}
+
 // generateID creates a deterministic-ish 21-char id using the allowed charset
 func generateID(seq int) string {
 	charset := "useandom26T198340PX75pxJACKVERYMINDBUSHWOLFGQZbfghjklqvwyzrict-"
@@ -59,11 +82,37 @@ func (s *store) get(resource, id string) (map[string]any, bool) {
 	defer s.mu.Unlock()
 	if r, ok := s.data[resource]; ok {
 		obj, found := r[id]
-		return obj, found
+		if !found {
+			return nil, false
+		}
+		// return a copy because objects may be updated in the background, e.g. while verifying domains
+		return copyObject(obj), true
 	}
 	return nil, false
 }
 
+func copyObject(obj map[string]any) map[string]any {
+	copied := make(map[string]any, len(obj))
+	for k, v := range obj {
+		copied[k] = v
+	}
+	return copied
+}
+
+// list returns all objects of a resource matching the filter in order of creation
+func (s *store) list(resource string, filter func(map[string]any) bool) []map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := []map[string]any{}
+	for _, id := range s.order[resource] {
+		obj := s.data[resource][id]
+		if filter == nil || filter(obj) {
+			result = append(result, copyObject(obj))
+		}
+	}
+	return result
+}
+
 func (s *store) update(resource, id string, obj map[string]any) (map[string]any, bool) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -73,7 +122,7 @@ func (s *store) update(resource, id string, obj map[string]any) (map[string]any,
 			for k, v := range obj {
 				r[id][k] = v
 			}
-			return r[id], true
+			return copyObject(r[id]), true
 		}
 	}
 	return nil, false
@@ -85,6 +134,12 @@ func (s *store) delete(resource, id string) bool {
 	if r, ok := s.data[resource]; ok {
 		if _, found := r[id]; found {
 			delete(r, id)
+			for i, orderedId := range s.order[resource] {
+				if orderedId == id {
+					s.order[resource] = append(s.order[resource][:i], s.order[resource][i+1:]...)
+					break
+				}
+			}
 			return true
 		}
 	}
@@ -93,10 +148,15 @@ func (s *store) delete(resource, id string) bool {
 
 var s = newStore()
 
+// defaultPageSize is deliberately small so that clients have to follow pagination links
+const defaultPageSize = 5
+
 func main() {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", rootHandler)
 
+	go probeListeners(livenessProbeTick)
+
 	addr := ":3000"
 	log.Printf("mock server listening on %s", addr)
 	log.Fatal(http.ListenAndServe(addr, loggingMiddleware(mux)))
@@ -110,7 +170,7 @@ func loggingMiddleware(h http.Handler) http.Handler {
 }
 
 func rootHandler(w http.ResponseWriter, r *http.Request) {
-	// expected resource base paths: /api_keys, /domains, /listeners, /queues
+	// expected resource base paths: /api_clients, /api_keys, /channels, /domains, /listeners, /queues, /schemas, /topics
 	path := r.URL.Path
 	parts := strings.Split(strings.Trim(path, "/"), "/")
 	// Return 200 for root path so readiness checks succeed
@@ -134,130 +194,524 @@ func rootHandler(w http.ResponseWriter, r *http.Request) {
 		if len(parts) > 3 {
 			listenerId = parts[3]
 		}
-		handleListener(w, r, id, listenerId)
+		handleNested(w, r, "listeners", "listener", "queue", id, listenerId)
+		return
+	}
+
+	// support nested subscription endpoints under topics: /topics/{topicId}/subscriptions[/{subscriptionId}]
+	if resource == "topics" && len(parts) > 2 && parts[2] == "subscriptions" {
+		var subscriptionId string
+		if len(parts) > 3 {
+			subscriptionId = parts[3]
+		}
+		handleNested(w, r, "subscriptions", "subscription", "topic", id, subscriptionId)
+		return
+	}
+
+	// support verification of domains: /domains/{domainId}/verify
+	if resource == "domains" && len(parts) == 3 && parts[2] == "verify" {
+		handleDomainVerify(w, r, id)
 		return
 	}
 
 	switch resource {
-	case "api_keys", "domains", "listeners", "queues":
+	case "api_clients", "api_keys", "channels", "domains", "listeners", "queues", "schemas", "topics":
 		handleResource(w, r, resource, id)
 	default:
-		http.NotFound(w, r)
+		writeError(w, http.StatusNotFound, "not found")
 	}
 }
 
-func handleListener(w http.ResponseWriter, r *http.Request, queueId, id string) {
-	key := "listener"
+// handleNested handles resources that only exist below a parent resource, e.g. listeners of a queue
+func handleNested(w http.ResponseWriter, r *http.Request, resource, key, parentKey, parentId, id string) {
 	switch r.Method {
 	case http.MethodPost:
 		body, err := io.ReadAll(r.Body)
 		if err != nil {
-			http.Error(w, "bad request", http.StatusBadRequest)
+			writeError(w, http.StatusBadRequest, "bad request")
 			return
 		}
 		var obj map[string]any
 		if len(body) == 0 {
 			obj = map[string]any{}
 		} else if err := json.Unmarshal(body, &obj); err != nil {
-			http.Error(w, "invalid json", http.StatusBadRequest)
+			writeError(w, http.StatusBadRequest, "invalid json")
 			return
 		}
-		// attach parent queue id
-		obj["queue"] = queueId
-		created := s.create("listeners", obj)
+		// attach parent id
+		obj[parentKey] = parentId
+		// like the API, enable listeners by default
+		if _, ok := obj["status"]; !ok && resource == "listeners" {
+			obj["status"] = "enabled"
+		}
+		if resource == "listeners" {
+			storeSigningSecret(obj)
+			applyNestedDefaults(obj, "delivery", listenerDeliveryDefaults)
+			applyNestedDefaults(obj, "liveness", listenerLivenessDefaults)
+		}
+		created := s.create(resource, obj)
 		resp := map[string]any{key: created}
 		writeJSON(w, resp)
 	case http.MethodGet:
 		if id == "" {
-			http.Error(w, "not implemented", http.StatusNotImplemented)
+			children := s.list(resource, func(obj map[string]any) bool {
+				return obj[parentKey] == parentId
+			})
+			writePage(w, r, resource, children)
 			return
 		}
-		if obj, ok := s.get("listeners", id); ok {
+		if obj, ok := s.get(resource, id); ok {
 			resp := map[string]any{key: obj}
 			writeJSON(w, resp)
 			return
 		}
-		http.Error(w, "not found", http.StatusNotFound)
+		writeError(w, http.StatusNotFound, "not found")
 	case http.MethodPut:
 		if id == "" {
-			http.Error(w, "bad request", http.StatusBadRequest)
+			writeError(w, http.StatusBadRequest, "bad request")
 			return
 		}
 		body, err := io.ReadAll(r.Body)
 		if err != nil {
-			http.Error(w, "bad request", http.StatusBadRequest)
+			writeError(w, http.StatusBadRequest, "bad request")
 			return
 		}
 		var obj map[string]any
 		if err := json.Unmarshal(body, &obj); err != nil {
-			http.Error(w, "invalid json", http.StatusBadRequest)
+			writeError(w, http.StatusBadRequest, "invalid json")
 			return
 		}
-		if updated, ok := s.update("listeners", id, obj); ok {
+		if resource == "listeners" {
+			storeSigningSecret(obj)
+			applyNestedDefaults(obj, "delivery", listenerDeliveryDefaults)
+			applyNestedDefaults(obj, "liveness", listenerLivenessDefaults)
+			resetLivenessState(id, obj)
+		}
+		if updated, ok := s.update(resource, id, obj); ok {
 			resp := map[string]any{key: updated}
 			writeJSON(w, resp)
 			return
 		}
-		http.Error(w, "not found", http.StatusNotFound)
+		writeError(w, http.StatusNotFound, "not found")
 	case http.MethodDelete:
 		if id == "" {
-			http.Error(w, "bad request", http.StatusBadRequest)
+			writeError(w, http.StatusBadRequest, "bad request")
 			return
 		}
-		if s.delete("listeners", id) {
+		if s.delete(resource, id) {
 			resp := map[string]any{"_links": map[string]any{}}
 			writeJSON(w, resp)
 			return
 		}
-		http.Error(w, "not found", http.StatusNotFound)
+		writeError(w, http.StatusNotFound, "not found")
 	default:
-		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// storeSigningSecret removes the signing secret of a listener from the request, like the API
+// never returns it, and only records when the secret was last set
+func storeSigningSecret(obj map[string]any) {
+	secret, _ := obj["signing_secret"].(string)
+	delete(obj, "signing_secret")
+	delete(obj, "signing_secret_updated_at")
+	if secret != "" {
+		obj["signing_secret_updated_at"] = time.Now().UnixMilli()
+	}
+}
+
+// resetLivenessState drops the liveness state of a listener if the request changes its
+// liveness settings, so that the liveness url is probed again right away
+func resetLivenessState(id string, obj map[string]any) {
+	delete(obj, "liveness_state")
+	listener, found := s.get("listeners", id)
+	if !found {
+		return
+	}
+	if liveness, ok := obj["liveness"]; ok && !reflect.DeepEqual(liveness, listener["liveness"]) {
+		obj["liveness_state"] = nil
 	}
 }
 
+// livenessProbeTick is how often the test server looks for listeners that are due to be probed
+const livenessProbeTick = 200 * time.Millisecond
+
+// probeListeners probes the liveness url of all listeners, like the API, and stores the result
+// as liveness state of the listener. Only liveness urls of the local host are probed, so that
+// tests do not send requests to real hosts.
+func probeListeners(tick time.Duration) {
+	var mu sync.Mutex
+	inFlight := map[string]bool{}
+
+	for range time.Tick(tick) {
+		listeners := s.list("listeners", func(obj map[string]any) bool {
+			livenessUrl, _ := obj["liveness_url"].(string)
+			return isLoopbackUrl(livenessUrl)
+		})
+		for _, listener := range listeners {
+			id, _ := listener["id"].(string)
+			mu.Lock()
+			if inFlight[id] || !livenessProbeDue(listener) {
+				mu.Unlock()
+				continue
+			}
+			inFlight[id] = true
+			mu.Unlock()
+
+			go func(listener map[string]any) {
+				defer func() {
+					mu.Lock()
+					delete(inFlight, id)
+					mu.Unlock()
+				}()
+
+				state := probeListener(listener)
+				// drop the result if the liveness settings changed while probing
+				current, found := s.get("listeners", id)
+				if !found || !reflect.DeepEqual(current["liveness"], listener["liveness"]) {
+					return
+				}
+				s.update("listeners", id, map[string]any{"liveness_state": state})
+			}(listener)
+		}
+	}
+}
+
+func isLoopbackUrl(rawUrl string) bool {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return false
+	}
+	if u.Hostname() == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(u.Hostname())
+	return ip != nil && ip.IsLoopback()
+}
+
+func livenessSetting(liveness map[string]any, key string, defaultValue int) int {
+	if value, ok := liveness[key].(float64); ok {
+		return int(value)
+	}
+	return defaultValue
+}
+
+func livenessProbeDue(listener map[string]any) bool {
+	liveness, _ := listener["liveness"].(map[string]any)
+	state, ok := listener["liveness_state"].(map[string]any)
+	if !ok {
+		return true
+	}
+	lastProbeAt, _ := state["last_probe_at"].(int64)
+	interval := time.Duration(livenessSetting(liveness, "interval_seconds", 30)) * time.Second
+	return time.Since(time.UnixMilli(lastProbeAt)) >= interval
+}
+
+// probeListener sends a GET request to the liveness url of a listener and returns the new liveness state
+func probeListener(listener map[string]any) map[string]any {
+	liveness, _ := listener["liveness"].(map[string]any)
+	livenessUrl, _ := listener["liveness_url"].(string)
+	timeout := time.Duration(livenessSetting(liveness, "timeout_ms", 5000)) * time.Millisecond
+	failureThreshold := livenessSetting(liveness, "failure_threshold", 3)
+	expectedStatus := livenessSetting(liveness, "expected_status", http.StatusOK)
+
+	result := "success"
+	client := http.Client{Timeout: timeout}
+	res, err := client.Get(livenessUrl)
+	if err != nil {
+		log.Printf("liveness probe of %s failed: %v", livenessUrl, err)
+		result = "failure"
+	} else {
+		res.Body.Close()
+		if res.StatusCode != expectedStatus {
+			log.Printf("liveness probe of %s returned status %d, expected %d", livenessUrl, res.StatusCode, expectedStatus)
+			result = "failure"
+		}
+	}
+
+	var consecutiveFailures int64
+	if result == "failure" {
+		previous, _ := listener["liveness_state"].(map[string]any)
+		consecutiveFailures, _ = previous["consecutive_failures"].(int64)
+		consecutiveFailures++
+	}
+
+	return map[string]any{
+		"last_probe_at":        time.Now().UnixMilli(),
+		"last_result":          result,
+		"consecutive_failures": consecutiveFailures,
+		"live":                 consecutiveFailures < int64(failureThreshold),
+	}
+}
+
+// checkChannelDomain writes an error and returns false if the domain referenced
+// by a channel does not exist or has not been verified yet
+func checkChannelDomain(w http.ResponseWriter, obj map[string]any) bool {
+	domainId, ok := obj["domain_id"].(string)
+	if !ok || domainId == "" {
+		return true
+	}
+	domain, found := s.get("domains", domainId)
+	if !found {
+		writeError(w, http.StatusUnprocessableEntity, "domain "+domainId+" does not exist")
+		return false
+	}
+	verification, _ := domain["verification"].(map[string]any)
+	if verified, _ := verification["verified"].(bool); !verified {
+		writeError(w, http.StatusUnprocessableEntity, "domain "+domainId+" is not verified")
+		return false
+	}
+	return true
+}
+
+// handleDomainVerify marks the verification of a domain as pending and, like the API,
+// checks the challenge in the background by fetching the challenge file from the domain
+func handleDomainVerify(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	domain, found := s.get("domains", id)
+	if !found {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	s.update("domains", id, map[string]any{"verification": map[string]any{"verified": false, "verified_at": 0, "status": "pending"}})
+	pending, _ := s.get("domains", id)
+	writeJSON(w, map[string]any{"domain": pending})
+
+	go func() {
+		verification := checkDomainChallenge(domain)
+		s.update("domains", id, map[string]any{"verification": verification})
+	}()
+}
+
+// newDomainChallenge returns a challenge matching the challenge type of the domain
+func newDomainChallenge(domain map[string]any) map[string]any {
+	if domain["challenge_type"] == "dns" {
+		hostname, _ := domain["hostname"].(string)
+		return map[string]any{"dns": map[string]any{"record_name": "_discue-challenge." + hostname, "record_type": "TXT", "record_value": "dns-challenge-content", "created_at": 0, "expires_at": 0}}
+	}
+	return map[string]any{"https": map[string]any{"file_content": "challenge-content", "file_name": "challenge-file.txt", "context_path": "/.well-known/acme-challenge/abcd", "created_at": 0, "expires_at": 0}}
+}
+
+func failedVerification(reason string) map[string]any {
+	return map[string]any{"verified": false, "verified_at": 0, "status": "failed", "reason": reason}
+}
+
+func checkDomainChallenge(domain map[string]any) map[string]any {
+	challenge, _ := domain["challenge"].(map[string]any)
+	if dns, ok := challenge["dns"].(map[string]any); ok {
+		return checkDnsChallenge(dns)
+	}
+
+	https, _ := challenge["https"].(map[string]any)
+	hostname, _ := domain["hostname"].(string)
+	port, _ := domain["port"].(float64)
+	contextPath, _ := https["context_path"].(string)
+	fileName, _ := https["file_name"].(string)
+	fileContent, _ := https["file_content"].(string)
+
+	// the test server has no certificates, so fetch the challenge via plain http
+	target := "http://" + hostname + ":" + strconv.Itoa(int(port)) + strings.TrimSuffix(contextPath, "/") + "/" + fileName
+	client := http.Client{Timeout: 10 * time.Second}
+	res, err := client.Get(target)
+	if err != nil {
+		return failedVerification("could not fetch challenge file: " + err.Error())
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return failedVerification("challenge file returned status " + strconv.Itoa(res.StatusCode))
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return failedVerification("could not read challenge file: " + err.Error())
+	}
+	if strings.TrimSpace(string(body)) != fileContent {
+		return failedVerification("challenge file content does not match")
+	}
+	return map[string]any{"verified": true, "verified_at": time.Now().UnixMilli(), "status": "verified"}
+}
+
+// dnsResolverAddress is the address of the stub resolver used to check dns challenges,
+// which tests can start to publish the expected TXT record
+func dnsResolverAddress() string {
+	if address := os.Getenv("DNS_RESOLVER_ADDRESS"); address != "" {
+		return address
+	}
+	return "127.0.0.1:5354"
+}
+
+func checkDnsChallenge(dns map[string]any) map[string]any {
+	recordName, _ := dns["record_name"].(string)
+	recordValue, _ := dns["record_value"].(string)
+
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			d := net.Dialer{}
+			return d.DialContext(ctx, network, dnsResolverAddress())
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	records, err := resolver.LookupTXT(ctx, recordName)
+	if err != nil {
+		return failedVerification("could not look up TXT record: " + err.Error())
+	}
+	for _, record := range records {
+		if record == recordValue {
+			return map[string]any{"verified": true, "verified_at": time.Now().UnixMilli(), "status": "verified"}
+		}
+	}
+	return failedVerification("TXT record does not contain the challenge")
+}
+
+// queueDefaults are the settings the API uses for queues that do not configure them
+var queueDefaults = map[string]any{
+	"message_retention_seconds": 4 * 24 * 60 * 60,
+	"retry_backoff_seconds":     10,
+}
+
+// applyDefaults sets all settings that are missing or null in the request to the
+// defaults, like the API does
+func applyDefaults(obj map[string]any, defaults map[string]any) {
+	for k, v := range defaults {
+		if obj[k] == nil {
+			obj[k] = v
+		}
+	}
+}
+
+// listenerDeliveryDefaults are the delivery settings the API uses for listeners that do not configure them
+var listenerDeliveryDefaults = map[string]any{
+	"timeout_ms": 10000,
+	"backoff":    "fixed",
+}
+
+// listenerLivenessDefaults are the liveness settings the API uses for listeners that do not configure them
+var listenerLivenessDefaults = map[string]any{
+	"interval_seconds":  30,
+	"timeout_ms":        5000,
+	"failure_threshold": 3,
+	"expected_status":   http.StatusOK,
+}
+
+// applyNestedDefaults sets all settings of the nested object at key that are missing or
+// null in the request to the defaults, like the API does
+func applyNestedDefaults(obj map[string]any, key string, defaults map[string]any) {
+	nested, _ := obj[key].(map[string]any)
+	if nested == nil {
+		nested = map[string]any{}
+	}
+	applyDefaults(nested, defaults)
+	obj[key] = nested
+}
+
+// checkDeadLetterQueue writes an error and returns false if the dead letter queue
+// referenced by a queue does not exist or is the queue itself
+func checkDeadLetterQueue(w http.ResponseWriter, queueId string, obj map[string]any) bool {
+	deadLetterQueueId, ok := obj["dead_letter_queue_id"].(string)
+	if !ok || deadLetterQueueId == "" {
+		return true
+	}
+	if deadLetterQueueId == queueId {
+		writeError(w, http.StatusUnprocessableEntity, "queue "+queueId+" cannot be its own dead letter queue")
+		return false
+	}
+	if _, found := s.get("queues", deadLetterQueueId); !found {
+		writeError(w, http.StatusUnprocessableEntity, "dead letter queue "+deadLetterQueueId+" does not exist")
+		return false
+	}
+	return true
+}
+
 func handleResource(w http.ResponseWriter, r *http.Request, resource, id string) {
 	// determine singular key
 	var key string
 	switch resource {
+	case "api_clients":
+		key = "api_client"
 	case "api_keys":
 		key = "api_key"
+	case "channels":
+		key = "channel"
 	case "domains":
 		key = "domain"
 	case "listeners":
 		key = "listener"
 	case "queues":
 		key = "queue"
+	case "schemas":
+		key = "schema"
+	case "topics":
+		key = "topic"
 	}
 
 	switch r.Method {
 	case http.MethodPost:
 		body, err := io.ReadAll(r.Body)
 		if err != nil {
-			http.Error(w, "bad request", http.StatusBadRequest)
+			writeError(w, http.StatusBadRequest, "bad request")
 			return
 		}
 		var obj map[string]any
 		if len(body) == 0 {
 			obj = map[string]any{}
 		} else if err := json.Unmarshal(body, &obj); err != nil {
-			http.Error(w, "invalid json", http.StatusBadRequest)
+			writeError(w, http.StatusBadRequest, "invalid json")
+			return
+		}
+		// like the API, only allow channels to push to verified domains
+		if resource == "channels" && !checkChannelDomain(w, obj) {
+			return
+		}
+		if resource == "queues" && !checkDeadLetterQueue(w, "", obj) {
 			return
 		}
+		if resource == "queues" {
+			applyDefaults(obj, queueDefaults)
+		}
 		created := s.create(resource, obj)
 		// Ensure domains include challenge and verification objects to match client expectations
 		if resource == "domains" {
+			if _, ok := created["challenge_type"]; !ok {
+				created["challenge_type"] = "https"
+			}
 			if _, ok := created["challenge"]; !ok {
-				created["challenge"] = map[string]any{"https": map[string]any{"file_content": "challenge-content", "file_name": "challenge-file.txt", "context_path": "/.well-known/acme-challenge/abcd", "created_at": 0, "expires_at": 0}}
+				created["challenge"] = newDomainChallenge(created)
 			}
 			if _, ok := created["verification"]; !ok {
 				created["verification"] = map[string]any{"verified": false, "verified_at": 0}
 			}
 		}
 		resp := map[string]any{key: created}
+		// like the API, return the whole api key only once and keep only its prefix
+		if resource == "api_keys" {
+			apiKey := generateApiKey()
+			s.update(resource, created["id"].(string), map[string]any{"key": apiKey[:apiKeyPrefixLength]})
+			withKey := map[string]any{}
+			for k, v := range created {
+				withKey[k] = v
+			}
+			withKey["key"] = apiKey
+			resp = map[string]any{key: withKey}
+		}
+		// like the API, return the client secret only once
+		if resource == "api_clients" {
+			withSecret := map[string]any{}
+			for k, v := range created {
+				withSecret[k] = v
+			}
+			withSecret["client_secret"] = generateApiKey()
+			resp = map[string]any{key: withSecret}
+		}
 		writeJSON(w, resp)
 	case http.MethodGet:
 		if id == "" {
-			http.Error(w, "not implemented", http.StatusNotImplemented)
+			writePage(w, r, resource, s.list(resource, nil))
 			return
 		}
 		if obj, ok := s.get(resource, id); ok {
@@ -265,31 +719,58 @@ func handleResource(w http.ResponseWriter, r *http.Request, resource, id string)
 			writeJSON(w, resp)
 			return
 		}
-		http.Error(w, "not found", http.StatusNotFound)
+		writeError(w, http.StatusNotFound, "not found")
 	case http.MethodPut:
 		if id == "" {
-			http.Error(w, "bad request", http.StatusBadRequest)
+			writeError(w, http.StatusBadRequest, "bad request")
 			return
 		}
 		body, err := io.ReadAll(r.Body)
 		if err != nil {
-			http.Error(w, "bad request", http.StatusBadRequest)
+			writeError(w, http.StatusBadRequest, "bad request")
 			return
 		}
 		var obj map[string]any
 		if err := json.Unmarshal(body, &obj); err != nil {
-			http.Error(w, "invalid json", http.StatusBadRequest)
+			writeError(w, http.StatusBadRequest, "invalid json")
+			return
+		}
+		// like the API, only allow channels to push to verified domains
+		if resource == "channels" && !checkChannelDomain(w, obj) {
+			return
+		}
+		if resource == "queues" && !checkDeadLetterQueue(w, id, obj) {
 			return
 		}
+		if resource == "queues" {
+			applyDefaults(obj, queueDefaults)
+		}
+		// like the API, refuse to change the target of a domain
+		if resource == "domains" {
+			_, hasHostname := obj["hostname"]
+			_, hasPort := obj["port"]
+			if hasHostname || hasPort {
+				writeError(w, http.StatusBadRequest, "hostname and port of a domain cannot be changed")
+				return
+			}
+			// like the API, issue a new challenge if the challenge type changes
+			if existing, found := s.get(resource, id); found {
+				if challengeType, ok := obj["challenge_type"]; ok && challengeType != existing["challenge_type"] {
+					existing["challenge_type"] = challengeType
+					obj["challenge"] = newDomainChallenge(existing)
+					obj["verification"] = map[string]any{"verified": false, "verified_at": 0}
+				}
+			}
+		}
 		if updated, ok := s.update(resource, id, obj); ok {
 			resp := map[string]any{key: updated}
 			writeJSON(w, resp)
 			return
 		}
-		http.Error(w, "not found", http.StatusNotFound)
+		writeError(w, http.StatusNotFound, "not found")
 	case http.MethodDelete:
 		if id == "" {
-			http.Error(w, "bad request", http.StatusBadRequest)
+			writeError(w, http.StatusBadRequest, "bad request")
 			return
 		}
 		if s.delete(resource, id) {
@@ -298,9 +779,9 @@ func handleResource(w http.ResponseWriter, r *http.Request, resource, id string)
 			writeJSON(w, resp)
 			return
 		}
-		http.Error(w, "not found", http.StatusNotFound)
+		writeError(w, http.StatusNotFound, "not found")
 	default:
-		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
 	}
 }
 
@@ -311,3 +792,53 @@ func writeJSON(w http.ResponseWriter, v any) {
 	enc.SetEscapeHTML(false)
 	_ = enc.Encode(v)
 }
+
+// writePage writes a single page of a list response including HAL links
+// to the next page. The page can be selected with the `page` and `page_size`
+// query parameters.
+func writePage(w http.ResponseWriter, r *http.Request, key string, objs []map[string]any) {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	pageSize, err := strconv.Atoi(r.URL.Query().Get("page_size"))
+	if err != nil || pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+
+	start := (page - 1) * pageSize
+	if start > len(objs) {
+		start = len(objs)
+	}
+	end := start + pageSize
+	if end > len(objs) {
+		end = len(objs)
+	}
+
+	links := map[string]any{
+		"self": map[string]any{"href": pageHref(r, page, pageSize)},
+	}
+	if end < len(objs) {
+		links["next"] = map[string]any{"href": pageHref(r, page+1, pageSize)}
+	}
+
+	writeJSON(w, map[string]any{key: objs[start:end], "_links": links})
+}
+
+func pageHref(r *http.Request, page, pageSize int) string {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("page_size", strconv.Itoa(pageSize))
+	return r.URL.Path + "?" + query.Encode()
+}
+
+// writeError mimics the error responses of the discue API
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("content-type", "application/json")
+	w.Header().Set("x-request-id", generateID(int(time.Now().UnixNano())))
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"code":    strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_"),
+		"message": message,
+	})
+}